@@ -0,0 +1,988 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gsgen -t arity; DO NOT EDIT.
+
+package goscala
+
+// Tuple2 is a value-type tuple of 2 elements.
+type Tuple2[A, B any] struct {
+	v1 A
+	v2 B
+}
+
+func T2[A, B any](v1 A, v2 B) Tuple2[A, B] {
+	return Tuple2[A, B]{v1: v1, v2: v2}
+}
+
+func (t Tuple2[A, B]) V1() A {
+	return t.v1
+}
+
+func (t Tuple2[A, B]) V2() B {
+	return t.v2
+}
+
+func (t Tuple2[A, B]) Get() (A, B) {
+	return t.v1, t.v2
+}
+
+// Tuple2MapV1 applies fn to the first element of t.
+func Tuple2MapV1[A, B, R any](t Tuple2[A, B], fn func(A) R) Tuple2[R, B] {
+	return T2(fn(t.v1), t.v2)
+}
+
+// Tuple2MapV2 applies fn to the second element of t.
+func Tuple2MapV2[A, B, R any](t Tuple2[A, B], fn func(B) R) Tuple2[A, R] {
+	return T2(t.v1, fn(t.v2))
+}
+
+// Currying2 converts f into a chain of single-argument functions.
+func Currying2[A, B, R any](f func(A, B) R) func(A) func(B) R {
+	return func(v1 A) func(B) R {
+		return func(v2 B) R {
+			return f(v1, v2)
+		}
+	}
+}
+
+// Uncurrying2 converts a chain of single-argument functions back into a function of 2 arguments.
+func Uncurrying2[A, B, R any](f func(A) func(B) R) func(A, B) R {
+	return func(v1 A, v2 B) R {
+		return f(v1)(v2)
+	}
+}
+
+// Tupled2 converts f into a function of Tuple2.
+func Tupled2[A, B, R any](f func(A, B) R) func(Tuple2[A, B]) R {
+	return func(t Tuple2[A, B]) R {
+		return f(t.Get())
+	}
+}
+
+// Untupled2 converts a function of Tuple2 into a function of 2 arguments.
+func Untupled2[A, B, R any](f func(Tuple2[A, B]) R) func(A, B) R {
+	return func(v1 A, v2 B) R {
+		return f(T2(v1, v2))
+	}
+}
+
+// Flip2 swaps the first two arguments of f.
+func Flip2[A, B, R any](f func(A, B) R) func(B, A) R {
+	return func(v2 B, v1 A) R {
+		return f(v1, v2)
+	}
+}
+
+// Apply1of2 fixes the first argument of f to v1.
+func Apply1of2[A, B, R any](f func(A, B) R, v1 A) func(B) R {
+	return func(v2 B) R {
+		return f(v1, v2)
+	}
+}
+
+// Apply2of2 fixes the second argument of f to v2.
+func Apply2of2[A, B, R any](f func(A, B) R, v2 B) func(A) R {
+	return func(v1 A) R {
+		return f(v1, v2)
+	}
+}
+
+// Tuple3 is a value-type tuple of 3 elements.
+type Tuple3[A, B, C any] struct {
+	v1 A
+	v2 B
+	v3 C
+}
+
+func T3[A, B, C any](v1 A, v2 B, v3 C) Tuple3[A, B, C] {
+	return Tuple3[A, B, C]{v1: v1, v2: v2, v3: v3}
+}
+
+func (t Tuple3[A, B, C]) V1() A {
+	return t.v1
+}
+
+func (t Tuple3[A, B, C]) V2() B {
+	return t.v2
+}
+
+func (t Tuple3[A, B, C]) V3() C {
+	return t.v3
+}
+
+func (t Tuple3[A, B, C]) Get() (A, B, C) {
+	return t.v1, t.v2, t.v3
+}
+
+// Tuple3MapV1 applies fn to the first element of t.
+func Tuple3MapV1[A, B, C, R any](t Tuple3[A, B, C], fn func(A) R) Tuple3[R, B, C] {
+	return T3(fn(t.v1), t.v2, t.v3)
+}
+
+// Tuple3MapV2 applies fn to the second element of t.
+func Tuple3MapV2[A, B, C, R any](t Tuple3[A, B, C], fn func(B) R) Tuple3[A, R, C] {
+	return T3(t.v1, fn(t.v2), t.v3)
+}
+
+// Tuple3MapV3 applies fn to the third element of t.
+func Tuple3MapV3[A, B, C, R any](t Tuple3[A, B, C], fn func(C) R) Tuple3[A, B, R] {
+	return T3(t.v1, t.v2, fn(t.v3))
+}
+
+// Currying3 converts f into a chain of single-argument functions.
+func Currying3[A, B, C, R any](f func(A, B, C) R) func(A) func(B) func(C) R {
+	return func(v1 A) func(B) func(C) R {
+		return func(v2 B) func(C) R {
+			return func(v3 C) R {
+				return f(v1, v2, v3)
+			}
+		}
+	}
+}
+
+// Uncurrying3 converts a chain of single-argument functions back into a function of 3 arguments.
+func Uncurrying3[A, B, C, R any](f func(A) func(B) func(C) R) func(A, B, C) R {
+	return func(v1 A, v2 B, v3 C) R {
+		return f(v1)(v2)(v3)
+	}
+}
+
+// Tupled3 converts f into a function of Tuple3.
+func Tupled3[A, B, C, R any](f func(A, B, C) R) func(Tuple3[A, B, C]) R {
+	return func(t Tuple3[A, B, C]) R {
+		return f(t.Get())
+	}
+}
+
+// Untupled3 converts a function of Tuple3 into a function of 3 arguments.
+func Untupled3[A, B, C, R any](f func(Tuple3[A, B, C]) R) func(A, B, C) R {
+	return func(v1 A, v2 B, v3 C) R {
+		return f(T3(v1, v2, v3))
+	}
+}
+
+// Flip3 swaps the first two arguments of f.
+func Flip3[A, B, C, R any](f func(A, B, C) R) func(B, A, C) R {
+	return func(v2 B, v1 A, v3 C) R {
+		return f(v1, v2, v3)
+	}
+}
+
+// Apply1of3 fixes the first argument of f to v1.
+func Apply1of3[A, B, C, R any](f func(A, B, C) R, v1 A) func(B, C) R {
+	return func(v2 B, v3 C) R {
+		return f(v1, v2, v3)
+	}
+}
+
+// Apply2of3 fixes the second argument of f to v2.
+func Apply2of3[A, B, C, R any](f func(A, B, C) R, v2 B) func(A, C) R {
+	return func(v1 A, v3 C) R {
+		return f(v1, v2, v3)
+	}
+}
+
+// Apply3of3 fixes the third argument of f to v3.
+func Apply3of3[A, B, C, R any](f func(A, B, C) R, v3 C) func(A, B) R {
+	return func(v1 A, v2 B) R {
+		return f(v1, v2, v3)
+	}
+}
+
+// Tuple4 is a value-type tuple of 4 elements.
+type Tuple4[A, B, C, D any] struct {
+	v1 A
+	v2 B
+	v3 C
+	v4 D
+}
+
+func T4[A, B, C, D any](v1 A, v2 B, v3 C, v4 D) Tuple4[A, B, C, D] {
+	return Tuple4[A, B, C, D]{v1: v1, v2: v2, v3: v3, v4: v4}
+}
+
+func (t Tuple4[A, B, C, D]) V1() A {
+	return t.v1
+}
+
+func (t Tuple4[A, B, C, D]) V2() B {
+	return t.v2
+}
+
+func (t Tuple4[A, B, C, D]) V3() C {
+	return t.v3
+}
+
+func (t Tuple4[A, B, C, D]) V4() D {
+	return t.v4
+}
+
+func (t Tuple4[A, B, C, D]) Get() (A, B, C, D) {
+	return t.v1, t.v2, t.v3, t.v4
+}
+
+// Tuple4MapV1 applies fn to the first element of t.
+func Tuple4MapV1[A, B, C, D, R any](t Tuple4[A, B, C, D], fn func(A) R) Tuple4[R, B, C, D] {
+	return T4(fn(t.v1), t.v2, t.v3, t.v4)
+}
+
+// Tuple4MapV2 applies fn to the second element of t.
+func Tuple4MapV2[A, B, C, D, R any](t Tuple4[A, B, C, D], fn func(B) R) Tuple4[A, R, C, D] {
+	return T4(t.v1, fn(t.v2), t.v3, t.v4)
+}
+
+// Tuple4MapV3 applies fn to the third element of t.
+func Tuple4MapV3[A, B, C, D, R any](t Tuple4[A, B, C, D], fn func(C) R) Tuple4[A, B, R, D] {
+	return T4(t.v1, t.v2, fn(t.v3), t.v4)
+}
+
+// Tuple4MapV4 applies fn to the fourth element of t.
+func Tuple4MapV4[A, B, C, D, R any](t Tuple4[A, B, C, D], fn func(D) R) Tuple4[A, B, C, R] {
+	return T4(t.v1, t.v2, t.v3, fn(t.v4))
+}
+
+// Currying4 converts f into a chain of single-argument functions.
+func Currying4[A, B, C, D, R any](f func(A, B, C, D) R) func(A) func(B) func(C) func(D) R {
+	return func(v1 A) func(B) func(C) func(D) R {
+		return func(v2 B) func(C) func(D) R {
+			return func(v3 C) func(D) R {
+				return func(v4 D) R {
+					return f(v1, v2, v3, v4)
+				}
+			}
+		}
+	}
+}
+
+// Uncurrying4 converts a chain of single-argument functions back into a function of 4 arguments.
+func Uncurrying4[A, B, C, D, R any](f func(A) func(B) func(C) func(D) R) func(A, B, C, D) R {
+	return func(v1 A, v2 B, v3 C, v4 D) R {
+		return f(v1)(v2)(v3)(v4)
+	}
+}
+
+// Tupled4 converts f into a function of Tuple4.
+func Tupled4[A, B, C, D, R any](f func(A, B, C, D) R) func(Tuple4[A, B, C, D]) R {
+	return func(t Tuple4[A, B, C, D]) R {
+		return f(t.Get())
+	}
+}
+
+// Untupled4 converts a function of Tuple4 into a function of 4 arguments.
+func Untupled4[A, B, C, D, R any](f func(Tuple4[A, B, C, D]) R) func(A, B, C, D) R {
+	return func(v1 A, v2 B, v3 C, v4 D) R {
+		return f(T4(v1, v2, v3, v4))
+	}
+}
+
+// Flip4 swaps the first two arguments of f.
+func Flip4[A, B, C, D, R any](f func(A, B, C, D) R) func(B, A, C, D) R {
+	return func(v2 B, v1 A, v3 C, v4 D) R {
+		return f(v1, v2, v3, v4)
+	}
+}
+
+// Apply1of4 fixes the first argument of f to v1.
+func Apply1of4[A, B, C, D, R any](f func(A, B, C, D) R, v1 A) func(B, C, D) R {
+	return func(v2 B, v3 C, v4 D) R {
+		return f(v1, v2, v3, v4)
+	}
+}
+
+// Apply2of4 fixes the second argument of f to v2.
+func Apply2of4[A, B, C, D, R any](f func(A, B, C, D) R, v2 B) func(A, C, D) R {
+	return func(v1 A, v3 C, v4 D) R {
+		return f(v1, v2, v3, v4)
+	}
+}
+
+// Apply3of4 fixes the third argument of f to v3.
+func Apply3of4[A, B, C, D, R any](f func(A, B, C, D) R, v3 C) func(A, B, D) R {
+	return func(v1 A, v2 B, v4 D) R {
+		return f(v1, v2, v3, v4)
+	}
+}
+
+// Apply4of4 fixes the fourth argument of f to v4.
+func Apply4of4[A, B, C, D, R any](f func(A, B, C, D) R, v4 D) func(A, B, C) R {
+	return func(v1 A, v2 B, v3 C) R {
+		return f(v1, v2, v3, v4)
+	}
+}
+
+// Tuple5 is a value-type tuple of 5 elements.
+type Tuple5[A, B, C, D, E any] struct {
+	v1 A
+	v2 B
+	v3 C
+	v4 D
+	v5 E
+}
+
+func T5[A, B, C, D, E any](v1 A, v2 B, v3 C, v4 D, v5 E) Tuple5[A, B, C, D, E] {
+	return Tuple5[A, B, C, D, E]{v1: v1, v2: v2, v3: v3, v4: v4, v5: v5}
+}
+
+func (t Tuple5[A, B, C, D, E]) V1() A {
+	return t.v1
+}
+
+func (t Tuple5[A, B, C, D, E]) V2() B {
+	return t.v2
+}
+
+func (t Tuple5[A, B, C, D, E]) V3() C {
+	return t.v3
+}
+
+func (t Tuple5[A, B, C, D, E]) V4() D {
+	return t.v4
+}
+
+func (t Tuple5[A, B, C, D, E]) V5() E {
+	return t.v5
+}
+
+func (t Tuple5[A, B, C, D, E]) Get() (A, B, C, D, E) {
+	return t.v1, t.v2, t.v3, t.v4, t.v5
+}
+
+// Tuple5MapV1 applies fn to the first element of t.
+func Tuple5MapV1[A, B, C, D, E, R any](t Tuple5[A, B, C, D, E], fn func(A) R) Tuple5[R, B, C, D, E] {
+	return T5(fn(t.v1), t.v2, t.v3, t.v4, t.v5)
+}
+
+// Tuple5MapV2 applies fn to the second element of t.
+func Tuple5MapV2[A, B, C, D, E, R any](t Tuple5[A, B, C, D, E], fn func(B) R) Tuple5[A, R, C, D, E] {
+	return T5(t.v1, fn(t.v2), t.v3, t.v4, t.v5)
+}
+
+// Tuple5MapV3 applies fn to the third element of t.
+func Tuple5MapV3[A, B, C, D, E, R any](t Tuple5[A, B, C, D, E], fn func(C) R) Tuple5[A, B, R, D, E] {
+	return T5(t.v1, t.v2, fn(t.v3), t.v4, t.v5)
+}
+
+// Tuple5MapV4 applies fn to the fourth element of t.
+func Tuple5MapV4[A, B, C, D, E, R any](t Tuple5[A, B, C, D, E], fn func(D) R) Tuple5[A, B, C, R, E] {
+	return T5(t.v1, t.v2, t.v3, fn(t.v4), t.v5)
+}
+
+// Tuple5MapV5 applies fn to the fifth element of t.
+func Tuple5MapV5[A, B, C, D, E, R any](t Tuple5[A, B, C, D, E], fn func(E) R) Tuple5[A, B, C, D, R] {
+	return T5(t.v1, t.v2, t.v3, t.v4, fn(t.v5))
+}
+
+// Currying5 converts f into a chain of single-argument functions.
+func Currying5[A, B, C, D, E, R any](f func(A, B, C, D, E) R) func(A) func(B) func(C) func(D) func(E) R {
+	return func(v1 A) func(B) func(C) func(D) func(E) R {
+		return func(v2 B) func(C) func(D) func(E) R {
+			return func(v3 C) func(D) func(E) R {
+				return func(v4 D) func(E) R {
+					return func(v5 E) R {
+						return f(v1, v2, v3, v4, v5)
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurrying5 converts a chain of single-argument functions back into a function of 5 arguments.
+func Uncurrying5[A, B, C, D, E, R any](f func(A) func(B) func(C) func(D) func(E) R) func(A, B, C, D, E) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v5 E) R {
+		return f(v1)(v2)(v3)(v4)(v5)
+	}
+}
+
+// Tupled5 converts f into a function of Tuple5.
+func Tupled5[A, B, C, D, E, R any](f func(A, B, C, D, E) R) func(Tuple5[A, B, C, D, E]) R {
+	return func(t Tuple5[A, B, C, D, E]) R {
+		return f(t.Get())
+	}
+}
+
+// Untupled5 converts a function of Tuple5 into a function of 5 arguments.
+func Untupled5[A, B, C, D, E, R any](f func(Tuple5[A, B, C, D, E]) R) func(A, B, C, D, E) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v5 E) R {
+		return f(T5(v1, v2, v3, v4, v5))
+	}
+}
+
+// Flip5 swaps the first two arguments of f.
+func Flip5[A, B, C, D, E, R any](f func(A, B, C, D, E) R) func(B, A, C, D, E) R {
+	return func(v2 B, v1 A, v3 C, v4 D, v5 E) R {
+		return f(v1, v2, v3, v4, v5)
+	}
+}
+
+// Apply1of5 fixes the first argument of f to v1.
+func Apply1of5[A, B, C, D, E, R any](f func(A, B, C, D, E) R, v1 A) func(B, C, D, E) R {
+	return func(v2 B, v3 C, v4 D, v5 E) R {
+		return f(v1, v2, v3, v4, v5)
+	}
+}
+
+// Apply2of5 fixes the second argument of f to v2.
+func Apply2of5[A, B, C, D, E, R any](f func(A, B, C, D, E) R, v2 B) func(A, C, D, E) R {
+	return func(v1 A, v3 C, v4 D, v5 E) R {
+		return f(v1, v2, v3, v4, v5)
+	}
+}
+
+// Apply3of5 fixes the third argument of f to v3.
+func Apply3of5[A, B, C, D, E, R any](f func(A, B, C, D, E) R, v3 C) func(A, B, D, E) R {
+	return func(v1 A, v2 B, v4 D, v5 E) R {
+		return f(v1, v2, v3, v4, v5)
+	}
+}
+
+// Apply4of5 fixes the fourth argument of f to v4.
+func Apply4of5[A, B, C, D, E, R any](f func(A, B, C, D, E) R, v4 D) func(A, B, C, E) R {
+	return func(v1 A, v2 B, v3 C, v5 E) R {
+		return f(v1, v2, v3, v4, v5)
+	}
+}
+
+// Apply5of5 fixes the fifth argument of f to v5.
+func Apply5of5[A, B, C, D, E, R any](f func(A, B, C, D, E) R, v5 E) func(A, B, C, D) R {
+	return func(v1 A, v2 B, v3 C, v4 D) R {
+		return f(v1, v2, v3, v4, v5)
+	}
+}
+
+// Tuple6 is a value-type tuple of 6 elements.
+type Tuple6[A, B, C, D, E, F any] struct {
+	v1 A
+	v2 B
+	v3 C
+	v4 D
+	v5 E
+	v6 F
+}
+
+func T6[A, B, C, D, E, F any](v1 A, v2 B, v3 C, v4 D, v5 E, v6 F) Tuple6[A, B, C, D, E, F] {
+	return Tuple6[A, B, C, D, E, F]{v1: v1, v2: v2, v3: v3, v4: v4, v5: v5, v6: v6}
+}
+
+func (t Tuple6[A, B, C, D, E, F]) V1() A {
+	return t.v1
+}
+
+func (t Tuple6[A, B, C, D, E, F]) V2() B {
+	return t.v2
+}
+
+func (t Tuple6[A, B, C, D, E, F]) V3() C {
+	return t.v3
+}
+
+func (t Tuple6[A, B, C, D, E, F]) V4() D {
+	return t.v4
+}
+
+func (t Tuple6[A, B, C, D, E, F]) V5() E {
+	return t.v5
+}
+
+func (t Tuple6[A, B, C, D, E, F]) V6() F {
+	return t.v6
+}
+
+func (t Tuple6[A, B, C, D, E, F]) Get() (A, B, C, D, E, F) {
+	return t.v1, t.v2, t.v3, t.v4, t.v5, t.v6
+}
+
+// Tuple6MapV1 applies fn to the first element of t.
+func Tuple6MapV1[A, B, C, D, E, F, R any](t Tuple6[A, B, C, D, E, F], fn func(A) R) Tuple6[R, B, C, D, E, F] {
+	return T6(fn(t.v1), t.v2, t.v3, t.v4, t.v5, t.v6)
+}
+
+// Tuple6MapV2 applies fn to the second element of t.
+func Tuple6MapV2[A, B, C, D, E, F, R any](t Tuple6[A, B, C, D, E, F], fn func(B) R) Tuple6[A, R, C, D, E, F] {
+	return T6(t.v1, fn(t.v2), t.v3, t.v4, t.v5, t.v6)
+}
+
+// Tuple6MapV3 applies fn to the third element of t.
+func Tuple6MapV3[A, B, C, D, E, F, R any](t Tuple6[A, B, C, D, E, F], fn func(C) R) Tuple6[A, B, R, D, E, F] {
+	return T6(t.v1, t.v2, fn(t.v3), t.v4, t.v5, t.v6)
+}
+
+// Tuple6MapV4 applies fn to the fourth element of t.
+func Tuple6MapV4[A, B, C, D, E, F, R any](t Tuple6[A, B, C, D, E, F], fn func(D) R) Tuple6[A, B, C, R, E, F] {
+	return T6(t.v1, t.v2, t.v3, fn(t.v4), t.v5, t.v6)
+}
+
+// Tuple6MapV5 applies fn to the fifth element of t.
+func Tuple6MapV5[A, B, C, D, E, F, R any](t Tuple6[A, B, C, D, E, F], fn func(E) R) Tuple6[A, B, C, D, R, F] {
+	return T6(t.v1, t.v2, t.v3, t.v4, fn(t.v5), t.v6)
+}
+
+// Tuple6MapV6 applies fn to the sixth element of t.
+func Tuple6MapV6[A, B, C, D, E, F, R any](t Tuple6[A, B, C, D, E, F], fn func(F) R) Tuple6[A, B, C, D, E, R] {
+	return T6(t.v1, t.v2, t.v3, t.v4, t.v5, fn(t.v6))
+}
+
+// Currying6 converts f into a chain of single-argument functions.
+func Currying6[A, B, C, D, E, F, R any](f func(A, B, C, D, E, F) R) func(A) func(B) func(C) func(D) func(E) func(F) R {
+	return func(v1 A) func(B) func(C) func(D) func(E) func(F) R {
+		return func(v2 B) func(C) func(D) func(E) func(F) R {
+			return func(v3 C) func(D) func(E) func(F) R {
+				return func(v4 D) func(E) func(F) R {
+					return func(v5 E) func(F) R {
+						return func(v6 F) R {
+							return f(v1, v2, v3, v4, v5, v6)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurrying6 converts a chain of single-argument functions back into a function of 6 arguments.
+func Uncurrying6[A, B, C, D, E, F, R any](f func(A) func(B) func(C) func(D) func(E) func(F) R) func(A, B, C, D, E, F) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v5 E, v6 F) R {
+		return f(v1)(v2)(v3)(v4)(v5)(v6)
+	}
+}
+
+// Tupled6 converts f into a function of Tuple6.
+func Tupled6[A, B, C, D, E, F, R any](f func(A, B, C, D, E, F) R) func(Tuple6[A, B, C, D, E, F]) R {
+	return func(t Tuple6[A, B, C, D, E, F]) R {
+		return f(t.Get())
+	}
+}
+
+// Untupled6 converts a function of Tuple6 into a function of 6 arguments.
+func Untupled6[A, B, C, D, E, F, R any](f func(Tuple6[A, B, C, D, E, F]) R) func(A, B, C, D, E, F) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v5 E, v6 F) R {
+		return f(T6(v1, v2, v3, v4, v5, v6))
+	}
+}
+
+// Flip6 swaps the first two arguments of f.
+func Flip6[A, B, C, D, E, F, R any](f func(A, B, C, D, E, F) R) func(B, A, C, D, E, F) R {
+	return func(v2 B, v1 A, v3 C, v4 D, v5 E, v6 F) R {
+		return f(v1, v2, v3, v4, v5, v6)
+	}
+}
+
+// Apply1of6 fixes the first argument of f to v1.
+func Apply1of6[A, B, C, D, E, F, R any](f func(A, B, C, D, E, F) R, v1 A) func(B, C, D, E, F) R {
+	return func(v2 B, v3 C, v4 D, v5 E, v6 F) R {
+		return f(v1, v2, v3, v4, v5, v6)
+	}
+}
+
+// Apply2of6 fixes the second argument of f to v2.
+func Apply2of6[A, B, C, D, E, F, R any](f func(A, B, C, D, E, F) R, v2 B) func(A, C, D, E, F) R {
+	return func(v1 A, v3 C, v4 D, v5 E, v6 F) R {
+		return f(v1, v2, v3, v4, v5, v6)
+	}
+}
+
+// Apply3of6 fixes the third argument of f to v3.
+func Apply3of6[A, B, C, D, E, F, R any](f func(A, B, C, D, E, F) R, v3 C) func(A, B, D, E, F) R {
+	return func(v1 A, v2 B, v4 D, v5 E, v6 F) R {
+		return f(v1, v2, v3, v4, v5, v6)
+	}
+}
+
+// Apply4of6 fixes the fourth argument of f to v4.
+func Apply4of6[A, B, C, D, E, F, R any](f func(A, B, C, D, E, F) R, v4 D) func(A, B, C, E, F) R {
+	return func(v1 A, v2 B, v3 C, v5 E, v6 F) R {
+		return f(v1, v2, v3, v4, v5, v6)
+	}
+}
+
+// Apply5of6 fixes the fifth argument of f to v5.
+func Apply5of6[A, B, C, D, E, F, R any](f func(A, B, C, D, E, F) R, v5 E) func(A, B, C, D, F) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v6 F) R {
+		return f(v1, v2, v3, v4, v5, v6)
+	}
+}
+
+// Apply6of6 fixes the sixth argument of f to v6.
+func Apply6of6[A, B, C, D, E, F, R any](f func(A, B, C, D, E, F) R, v6 F) func(A, B, C, D, E) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v5 E) R {
+		return f(v1, v2, v3, v4, v5, v6)
+	}
+}
+
+// Tuple7 is a value-type tuple of 7 elements.
+type Tuple7[A, B, C, D, E, F, G any] struct {
+	v1 A
+	v2 B
+	v3 C
+	v4 D
+	v5 E
+	v6 F
+	v7 G
+}
+
+func T7[A, B, C, D, E, F, G any](v1 A, v2 B, v3 C, v4 D, v5 E, v6 F, v7 G) Tuple7[A, B, C, D, E, F, G] {
+	return Tuple7[A, B, C, D, E, F, G]{v1: v1, v2: v2, v3: v3, v4: v4, v5: v5, v6: v6, v7: v7}
+}
+
+func (t Tuple7[A, B, C, D, E, F, G]) V1() A {
+	return t.v1
+}
+
+func (t Tuple7[A, B, C, D, E, F, G]) V2() B {
+	return t.v2
+}
+
+func (t Tuple7[A, B, C, D, E, F, G]) V3() C {
+	return t.v3
+}
+
+func (t Tuple7[A, B, C, D, E, F, G]) V4() D {
+	return t.v4
+}
+
+func (t Tuple7[A, B, C, D, E, F, G]) V5() E {
+	return t.v5
+}
+
+func (t Tuple7[A, B, C, D, E, F, G]) V6() F {
+	return t.v6
+}
+
+func (t Tuple7[A, B, C, D, E, F, G]) V7() G {
+	return t.v7
+}
+
+func (t Tuple7[A, B, C, D, E, F, G]) Get() (A, B, C, D, E, F, G) {
+	return t.v1, t.v2, t.v3, t.v4, t.v5, t.v6, t.v7
+}
+
+// Tuple7MapV1 applies fn to the first element of t.
+func Tuple7MapV1[A, B, C, D, E, F, G, R any](t Tuple7[A, B, C, D, E, F, G], fn func(A) R) Tuple7[R, B, C, D, E, F, G] {
+	return T7(fn(t.v1), t.v2, t.v3, t.v4, t.v5, t.v6, t.v7)
+}
+
+// Tuple7MapV2 applies fn to the second element of t.
+func Tuple7MapV2[A, B, C, D, E, F, G, R any](t Tuple7[A, B, C, D, E, F, G], fn func(B) R) Tuple7[A, R, C, D, E, F, G] {
+	return T7(t.v1, fn(t.v2), t.v3, t.v4, t.v5, t.v6, t.v7)
+}
+
+// Tuple7MapV3 applies fn to the third element of t.
+func Tuple7MapV3[A, B, C, D, E, F, G, R any](t Tuple7[A, B, C, D, E, F, G], fn func(C) R) Tuple7[A, B, R, D, E, F, G] {
+	return T7(t.v1, t.v2, fn(t.v3), t.v4, t.v5, t.v6, t.v7)
+}
+
+// Tuple7MapV4 applies fn to the fourth element of t.
+func Tuple7MapV4[A, B, C, D, E, F, G, R any](t Tuple7[A, B, C, D, E, F, G], fn func(D) R) Tuple7[A, B, C, R, E, F, G] {
+	return T7(t.v1, t.v2, t.v3, fn(t.v4), t.v5, t.v6, t.v7)
+}
+
+// Tuple7MapV5 applies fn to the fifth element of t.
+func Tuple7MapV5[A, B, C, D, E, F, G, R any](t Tuple7[A, B, C, D, E, F, G], fn func(E) R) Tuple7[A, B, C, D, R, F, G] {
+	return T7(t.v1, t.v2, t.v3, t.v4, fn(t.v5), t.v6, t.v7)
+}
+
+// Tuple7MapV6 applies fn to the sixth element of t.
+func Tuple7MapV6[A, B, C, D, E, F, G, R any](t Tuple7[A, B, C, D, E, F, G], fn func(F) R) Tuple7[A, B, C, D, E, R, G] {
+	return T7(t.v1, t.v2, t.v3, t.v4, t.v5, fn(t.v6), t.v7)
+}
+
+// Tuple7MapV7 applies fn to the seventh element of t.
+func Tuple7MapV7[A, B, C, D, E, F, G, R any](t Tuple7[A, B, C, D, E, F, G], fn func(G) R) Tuple7[A, B, C, D, E, F, R] {
+	return T7(t.v1, t.v2, t.v3, t.v4, t.v5, t.v6, fn(t.v7))
+}
+
+// Currying7 converts f into a chain of single-argument functions.
+func Currying7[A, B, C, D, E, F, G, R any](f func(A, B, C, D, E, F, G) R) func(A) func(B) func(C) func(D) func(E) func(F) func(G) R {
+	return func(v1 A) func(B) func(C) func(D) func(E) func(F) func(G) R {
+		return func(v2 B) func(C) func(D) func(E) func(F) func(G) R {
+			return func(v3 C) func(D) func(E) func(F) func(G) R {
+				return func(v4 D) func(E) func(F) func(G) R {
+					return func(v5 E) func(F) func(G) R {
+						return func(v6 F) func(G) R {
+							return func(v7 G) R {
+								return f(v1, v2, v3, v4, v5, v6, v7)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurrying7 converts a chain of single-argument functions back into a function of 7 arguments.
+func Uncurrying7[A, B, C, D, E, F, G, R any](f func(A) func(B) func(C) func(D) func(E) func(F) func(G) R) func(A, B, C, D, E, F, G) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v5 E, v6 F, v7 G) R {
+		return f(v1)(v2)(v3)(v4)(v5)(v6)(v7)
+	}
+}
+
+// Tupled7 converts f into a function of Tuple7.
+func Tupled7[A, B, C, D, E, F, G, R any](f func(A, B, C, D, E, F, G) R) func(Tuple7[A, B, C, D, E, F, G]) R {
+	return func(t Tuple7[A, B, C, D, E, F, G]) R {
+		return f(t.Get())
+	}
+}
+
+// Untupled7 converts a function of Tuple7 into a function of 7 arguments.
+func Untupled7[A, B, C, D, E, F, G, R any](f func(Tuple7[A, B, C, D, E, F, G]) R) func(A, B, C, D, E, F, G) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v5 E, v6 F, v7 G) R {
+		return f(T7(v1, v2, v3, v4, v5, v6, v7))
+	}
+}
+
+// Flip7 swaps the first two arguments of f.
+func Flip7[A, B, C, D, E, F, G, R any](f func(A, B, C, D, E, F, G) R) func(B, A, C, D, E, F, G) R {
+	return func(v2 B, v1 A, v3 C, v4 D, v5 E, v6 F, v7 G) R {
+		return f(v1, v2, v3, v4, v5, v6, v7)
+	}
+}
+
+// Apply1of7 fixes the first argument of f to v1.
+func Apply1of7[A, B, C, D, E, F, G, R any](f func(A, B, C, D, E, F, G) R, v1 A) func(B, C, D, E, F, G) R {
+	return func(v2 B, v3 C, v4 D, v5 E, v6 F, v7 G) R {
+		return f(v1, v2, v3, v4, v5, v6, v7)
+	}
+}
+
+// Apply2of7 fixes the second argument of f to v2.
+func Apply2of7[A, B, C, D, E, F, G, R any](f func(A, B, C, D, E, F, G) R, v2 B) func(A, C, D, E, F, G) R {
+	return func(v1 A, v3 C, v4 D, v5 E, v6 F, v7 G) R {
+		return f(v1, v2, v3, v4, v5, v6, v7)
+	}
+}
+
+// Apply3of7 fixes the third argument of f to v3.
+func Apply3of7[A, B, C, D, E, F, G, R any](f func(A, B, C, D, E, F, G) R, v3 C) func(A, B, D, E, F, G) R {
+	return func(v1 A, v2 B, v4 D, v5 E, v6 F, v7 G) R {
+		return f(v1, v2, v3, v4, v5, v6, v7)
+	}
+}
+
+// Apply4of7 fixes the fourth argument of f to v4.
+func Apply4of7[A, B, C, D, E, F, G, R any](f func(A, B, C, D, E, F, G) R, v4 D) func(A, B, C, E, F, G) R {
+	return func(v1 A, v2 B, v3 C, v5 E, v6 F, v7 G) R {
+		return f(v1, v2, v3, v4, v5, v6, v7)
+	}
+}
+
+// Apply5of7 fixes the fifth argument of f to v5.
+func Apply5of7[A, B, C, D, E, F, G, R any](f func(A, B, C, D, E, F, G) R, v5 E) func(A, B, C, D, F, G) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v6 F, v7 G) R {
+		return f(v1, v2, v3, v4, v5, v6, v7)
+	}
+}
+
+// Apply6of7 fixes the sixth argument of f to v6.
+func Apply6of7[A, B, C, D, E, F, G, R any](f func(A, B, C, D, E, F, G) R, v6 F) func(A, B, C, D, E, G) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v5 E, v7 G) R {
+		return f(v1, v2, v3, v4, v5, v6, v7)
+	}
+}
+
+// Apply7of7 fixes the seventh argument of f to v7.
+func Apply7of7[A, B, C, D, E, F, G, R any](f func(A, B, C, D, E, F, G) R, v7 G) func(A, B, C, D, E, F) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v5 E, v6 F) R {
+		return f(v1, v2, v3, v4, v5, v6, v7)
+	}
+}
+
+// Tuple8 is a value-type tuple of 8 elements.
+type Tuple8[A, B, C, D, E, F, G, H any] struct {
+	v1 A
+	v2 B
+	v3 C
+	v4 D
+	v5 E
+	v6 F
+	v7 G
+	v8 H
+}
+
+func T8[A, B, C, D, E, F, G, H any](v1 A, v2 B, v3 C, v4 D, v5 E, v6 F, v7 G, v8 H) Tuple8[A, B, C, D, E, F, G, H] {
+	return Tuple8[A, B, C, D, E, F, G, H]{v1: v1, v2: v2, v3: v3, v4: v4, v5: v5, v6: v6, v7: v7, v8: v8}
+}
+
+func (t Tuple8[A, B, C, D, E, F, G, H]) V1() A {
+	return t.v1
+}
+
+func (t Tuple8[A, B, C, D, E, F, G, H]) V2() B {
+	return t.v2
+}
+
+func (t Tuple8[A, B, C, D, E, F, G, H]) V3() C {
+	return t.v3
+}
+
+func (t Tuple8[A, B, C, D, E, F, G, H]) V4() D {
+	return t.v4
+}
+
+func (t Tuple8[A, B, C, D, E, F, G, H]) V5() E {
+	return t.v5
+}
+
+func (t Tuple8[A, B, C, D, E, F, G, H]) V6() F {
+	return t.v6
+}
+
+func (t Tuple8[A, B, C, D, E, F, G, H]) V7() G {
+	return t.v7
+}
+
+func (t Tuple8[A, B, C, D, E, F, G, H]) V8() H {
+	return t.v8
+}
+
+func (t Tuple8[A, B, C, D, E, F, G, H]) Get() (A, B, C, D, E, F, G, H) {
+	return t.v1, t.v2, t.v3, t.v4, t.v5, t.v6, t.v7, t.v8
+}
+
+// Tuple8MapV1 applies fn to the first element of t.
+func Tuple8MapV1[A, B, C, D, E, F, G, H, R any](t Tuple8[A, B, C, D, E, F, G, H], fn func(A) R) Tuple8[R, B, C, D, E, F, G, H] {
+	return T8(fn(t.v1), t.v2, t.v3, t.v4, t.v5, t.v6, t.v7, t.v8)
+}
+
+// Tuple8MapV2 applies fn to the second element of t.
+func Tuple8MapV2[A, B, C, D, E, F, G, H, R any](t Tuple8[A, B, C, D, E, F, G, H], fn func(B) R) Tuple8[A, R, C, D, E, F, G, H] {
+	return T8(t.v1, fn(t.v2), t.v3, t.v4, t.v5, t.v6, t.v7, t.v8)
+}
+
+// Tuple8MapV3 applies fn to the third element of t.
+func Tuple8MapV3[A, B, C, D, E, F, G, H, R any](t Tuple8[A, B, C, D, E, F, G, H], fn func(C) R) Tuple8[A, B, R, D, E, F, G, H] {
+	return T8(t.v1, t.v2, fn(t.v3), t.v4, t.v5, t.v6, t.v7, t.v8)
+}
+
+// Tuple8MapV4 applies fn to the fourth element of t.
+func Tuple8MapV4[A, B, C, D, E, F, G, H, R any](t Tuple8[A, B, C, D, E, F, G, H], fn func(D) R) Tuple8[A, B, C, R, E, F, G, H] {
+	return T8(t.v1, t.v2, t.v3, fn(t.v4), t.v5, t.v6, t.v7, t.v8)
+}
+
+// Tuple8MapV5 applies fn to the fifth element of t.
+func Tuple8MapV5[A, B, C, D, E, F, G, H, R any](t Tuple8[A, B, C, D, E, F, G, H], fn func(E) R) Tuple8[A, B, C, D, R, F, G, H] {
+	return T8(t.v1, t.v2, t.v3, t.v4, fn(t.v5), t.v6, t.v7, t.v8)
+}
+
+// Tuple8MapV6 applies fn to the sixth element of t.
+func Tuple8MapV6[A, B, C, D, E, F, G, H, R any](t Tuple8[A, B, C, D, E, F, G, H], fn func(F) R) Tuple8[A, B, C, D, E, R, G, H] {
+	return T8(t.v1, t.v2, t.v3, t.v4, t.v5, fn(t.v6), t.v7, t.v8)
+}
+
+// Tuple8MapV7 applies fn to the seventh element of t.
+func Tuple8MapV7[A, B, C, D, E, F, G, H, R any](t Tuple8[A, B, C, D, E, F, G, H], fn func(G) R) Tuple8[A, B, C, D, E, F, R, H] {
+	return T8(t.v1, t.v2, t.v3, t.v4, t.v5, t.v6, fn(t.v7), t.v8)
+}
+
+// Tuple8MapV8 applies fn to the eighth element of t.
+func Tuple8MapV8[A, B, C, D, E, F, G, H, R any](t Tuple8[A, B, C, D, E, F, G, H], fn func(H) R) Tuple8[A, B, C, D, E, F, G, R] {
+	return T8(t.v1, t.v2, t.v3, t.v4, t.v5, t.v6, t.v7, fn(t.v8))
+}
+
+// Currying8 converts f into a chain of single-argument functions.
+func Currying8[A, B, C, D, E, F, G, H, R any](f func(A, B, C, D, E, F, G, H) R) func(A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) R {
+	return func(v1 A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) R {
+		return func(v2 B) func(C) func(D) func(E) func(F) func(G) func(H) R {
+			return func(v3 C) func(D) func(E) func(F) func(G) func(H) R {
+				return func(v4 D) func(E) func(F) func(G) func(H) R {
+					return func(v5 E) func(F) func(G) func(H) R {
+						return func(v6 F) func(G) func(H) R {
+							return func(v7 G) func(H) R {
+								return func(v8 H) R {
+									return f(v1, v2, v3, v4, v5, v6, v7, v8)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurrying8 converts a chain of single-argument functions back into a function of 8 arguments.
+func Uncurrying8[A, B, C, D, E, F, G, H, R any](f func(A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) R) func(A, B, C, D, E, F, G, H) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v5 E, v6 F, v7 G, v8 H) R {
+		return f(v1)(v2)(v3)(v4)(v5)(v6)(v7)(v8)
+	}
+}
+
+// Tupled8 converts f into a function of Tuple8.
+func Tupled8[A, B, C, D, E, F, G, H, R any](f func(A, B, C, D, E, F, G, H) R) func(Tuple8[A, B, C, D, E, F, G, H]) R {
+	return func(t Tuple8[A, B, C, D, E, F, G, H]) R {
+		return f(t.Get())
+	}
+}
+
+// Untupled8 converts a function of Tuple8 into a function of 8 arguments.
+func Untupled8[A, B, C, D, E, F, G, H, R any](f func(Tuple8[A, B, C, D, E, F, G, H]) R) func(A, B, C, D, E, F, G, H) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v5 E, v6 F, v7 G, v8 H) R {
+		return f(T8(v1, v2, v3, v4, v5, v6, v7, v8))
+	}
+}
+
+// Flip8 swaps the first two arguments of f.
+func Flip8[A, B, C, D, E, F, G, H, R any](f func(A, B, C, D, E, F, G, H) R) func(B, A, C, D, E, F, G, H) R {
+	return func(v2 B, v1 A, v3 C, v4 D, v5 E, v6 F, v7 G, v8 H) R {
+		return f(v1, v2, v3, v4, v5, v6, v7, v8)
+	}
+}
+
+// Apply1of8 fixes the first argument of f to v1.
+func Apply1of8[A, B, C, D, E, F, G, H, R any](f func(A, B, C, D, E, F, G, H) R, v1 A) func(B, C, D, E, F, G, H) R {
+	return func(v2 B, v3 C, v4 D, v5 E, v6 F, v7 G, v8 H) R {
+		return f(v1, v2, v3, v4, v5, v6, v7, v8)
+	}
+}
+
+// Apply2of8 fixes the second argument of f to v2.
+func Apply2of8[A, B, C, D, E, F, G, H, R any](f func(A, B, C, D, E, F, G, H) R, v2 B) func(A, C, D, E, F, G, H) R {
+	return func(v1 A, v3 C, v4 D, v5 E, v6 F, v7 G, v8 H) R {
+		return f(v1, v2, v3, v4, v5, v6, v7, v8)
+	}
+}
+
+// Apply3of8 fixes the third argument of f to v3.
+func Apply3of8[A, B, C, D, E, F, G, H, R any](f func(A, B, C, D, E, F, G, H) R, v3 C) func(A, B, D, E, F, G, H) R {
+	return func(v1 A, v2 B, v4 D, v5 E, v6 F, v7 G, v8 H) R {
+		return f(v1, v2, v3, v4, v5, v6, v7, v8)
+	}
+}
+
+// Apply4of8 fixes the fourth argument of f to v4.
+func Apply4of8[A, B, C, D, E, F, G, H, R any](f func(A, B, C, D, E, F, G, H) R, v4 D) func(A, B, C, E, F, G, H) R {
+	return func(v1 A, v2 B, v3 C, v5 E, v6 F, v7 G, v8 H) R {
+		return f(v1, v2, v3, v4, v5, v6, v7, v8)
+	}
+}
+
+// Apply5of8 fixes the fifth argument of f to v5.
+func Apply5of8[A, B, C, D, E, F, G, H, R any](f func(A, B, C, D, E, F, G, H) R, v5 E) func(A, B, C, D, F, G, H) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v6 F, v7 G, v8 H) R {
+		return f(v1, v2, v3, v4, v5, v6, v7, v8)
+	}
+}
+
+// Apply6of8 fixes the sixth argument of f to v6.
+func Apply6of8[A, B, C, D, E, F, G, H, R any](f func(A, B, C, D, E, F, G, H) R, v6 F) func(A, B, C, D, E, G, H) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v5 E, v7 G, v8 H) R {
+		return f(v1, v2, v3, v4, v5, v6, v7, v8)
+	}
+}
+
+// Apply7of8 fixes the seventh argument of f to v7.
+func Apply7of8[A, B, C, D, E, F, G, H, R any](f func(A, B, C, D, E, F, G, H) R, v7 G) func(A, B, C, D, E, F, H) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v5 E, v6 F, v8 H) R {
+		return f(v1, v2, v3, v4, v5, v6, v7, v8)
+	}
+}
+
+// Apply8of8 fixes the eighth argument of f to v8.
+func Apply8of8[A, B, C, D, E, F, G, H, R any](f func(A, B, C, D, E, F, G, H) R, v8 H) func(A, B, C, D, E, F, G) R {
+	return func(v1 A, v2 B, v3 C, v4 D, v5 E, v6 F, v7 G) R {
+		return f(v1, v2, v3, v4, v5, v6, v7, v8)
+	}
+}
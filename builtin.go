@@ -6,7 +6,7 @@
 package goscala
 
 import (
-	"constraints"
+	"cmp"
 	"reflect"
 )
 
@@ -19,21 +19,21 @@ func TypeStr(x interface{}) string {
 	return reflect.TypeOf(x).String()
 }
 
-func Max[T constraints.Ordered](a, b T) T {
+func Max[T cmp.Ordered](a, b T) T {
 	if a > b {
 		return a
 	}
 	return b
 }
 
-func Min[T constraints.Ordered](a, b T) T {
+func Min[T cmp.Ordered](a, b T) T {
 	if a < b {
 		return a
 	}
 	return b
 }
 
-func Compare[T constraints.Ordered](a, b T) int {
+func Compare[T cmp.Ordered](a, b T) int {
 	if a == b {
 		return 0
 	}
@@ -0,0 +1,249 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl templates/*.inc
+var builtin embed.FS
+
+// maxTuple is the highest arity of TupleN in the gs package.
+const maxTuple = 8
+
+// letters are the type parameters, in order. L and R are kept for the left
+// and result types.
+var letters = strings.Split(`A B C D E F G H I J K M N O P Q S T U V W X Y Z`, ` `)
+
+// Data is passed to templates.
+type Data struct {
+	Pkg     string
+	Arities []int
+	Monad   Monad
+}
+
+// Apply is the argument of the apply template, the body of MapN and ZipN.
+type Apply struct {
+	Monad Monad
+	N     int
+	Fn    string // Fn is the function applied to the values, such as fn.
+	R     string // R is the result type of Fn.
+}
+
+// Monad describes the monad of the mapn, zip and for templates.
+type Monad struct {
+	Name string // Name is the -monad option, such as option.
+	Type string // Type is the monad type, such as gs.Option.
+	Left string // Left is the extra leading type parameter, such as L of gs.Either.
+	Fail string // Fail describes the result when any of the values is missing.
+}
+
+// Of returns the monad type of t, such as gs.Either[L, t].
+func (m Monad) Of(t string) string {
+	if m.Left != `` {
+		return m.Type + `[` + m.Left + `, ` + t + `]`
+	}
+	return m.Type + `[` + t + `]`
+}
+
+// TypeParams returns the leading type parameters, such as "L, ".
+func (m Monad) TypeParams() string {
+	if m.Left != `` {
+		return m.Left + `, `
+	}
+	return ``
+}
+
+var monads = map[string]Monad{
+	`option`:    {Name: `option`, Type: `gs.Option`, Fail: `returns None if any of them is None`},
+	`try`:       {Name: `try`, Type: `gs.Try`, Fail: `returns the first Failure`},
+	`either`:    {Name: `either`, Type: `gs.Either`, Left: `L`, Fail: `returns the first Left`},
+	`validated`: {Name: `validated`, Type: `gs.Validated`, Left: `L`, Fail: `returns Invalid with the errors of all of them`},
+}
+
+// list joins format for i in [start, n), with %d replaced by i+1, %s by
+// the i-th letter and %f by the i-th field, the letter in lower case.
+func list(n, start int, format string) string {
+	s := make([]string, 0, n)
+	for i := start; i < n; i++ {
+		s = append(s, strings.NewReplacer(`%d`, fmt.Sprint(i+1), `%s`, letters[i], `%f`, field(i)).Replace(format))
+	}
+	return strings.Join(s, `, `)
+}
+
+func field(i int) string {
+	return strings.ToLower(letters[i])
+}
+
+// listAt is list from 0, with at as the format of the x-th element.
+func listAt(n, x int, format, at string) string {
+	return strings.Trim(list(x, 0, format)+`, `+list(x+1, x, at)+`, `+list(n, x+1, format), `, `)
+}
+
+var ordinals = []string{`zeroth`, `first`, `second`, `third`, `fourth`, `fifth`, `sixth`, `seventh`, `eighth`, `ninth`, `tenth`}
+
+var cardinals = []string{`zero`, `one`, `two`, `three`, `four`, `five`, `six`, `seven`, `eight`, `nine`, `ten`}
+
+var funcs = template.FuncMap{
+	// seq returns 0, 1, ..., n-1.
+	`seq`: func(n int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = i
+		}
+		return s
+	},
+	// rseq returns n-1, ..., 1, 0.
+	`rseq`: func(n int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = n - 1 - i
+		}
+		return s
+	},
+	`inc`: func(i int) int { return i + 1 },
+	`dec`: func(i int) int { return i - 1 },
+	`sub`: func(a, b int) int { return a - b },
+	// typ is the i-th type parameter.
+	`typ`: func(i int) string { return letters[i] },
+	// val is the i-th argument.
+	`val`: func(i int) string { return fmt.Sprintf(`v%d`, i+1) },
+	// field is the i-th struct field.
+	`field`: field,
+	// list is "x2 B, x3 C" for {{list 3 1 "x%d %s"}}.
+	`list`: list,
+	// listAt is "t.v1, fn(t.v2), t.v3" for {{listAt 3 1 "t.v%d" "fn(t.v%d)"}}.
+	`listAt`: listAt,
+	// types is "A, B, C" for n = 3.
+	`types`: func(n int) string { return list(n, 0, `%s`) },
+	// vals is "v1, v2, v3" for n = 3.
+	`vals`: func(n int) string { return list(n, 0, `v%d`) },
+	// params is "v1 A, v2 B, v3 C" for n = 3.
+	`params`: func(n int) string { return list(n, 0, `v%d %s`) },
+	// curried is "func(B) func(C) R" for n = 3 and i = 1.
+	`curried`: func(n, i int) string {
+		s := ``
+		for ; i < n; i++ {
+			s += `func(` + letters[i] + `) `
+		}
+		return s + `R`
+	},
+	// flipped is "B, A, C" for n = 3.
+	`flipped`: func(n int) string {
+		return strings.Join(append([]string{letters[1], letters[0]}, letters[2:n]...), `, `)
+	},
+	// flippedParams is "v2 B, v1 A, v3 C" for n = 3.
+	`flippedParams`: func(n int) string {
+		if n == 2 {
+			return `v2 B, v1 A`
+		}
+		return `v2 B, v1 A, ` + list(n, 2, `v%d %s`)
+	},
+	// without is "v1 A, v3 C" for n = 3 and x = 1.
+	`without`: func(n, x int) string {
+		return strings.Trim(list(x, 0, `v%d %s`)+`, `+list(n, x+1, `v%d %s`), `, `)
+	},
+	// withoutTypes is "A, C" for n = 3 and x = 1.
+	`withoutTypes`: func(n, x int) string {
+		return strings.Trim(list(x, 0, `%s`)+`, `+list(n, x+1, `%s`), `, `)
+	},
+	// and is "v1, v2 and v3" for n = 3.
+	`and`: func(n int) string {
+		return list(n-1, 0, `v%d`) + fmt.Sprintf(` and v%d`, n)
+	},
+	`ordinal`: func(i int) string {
+		if i < len(ordinals) {
+			return ordinals[i]
+		}
+		return fmt.Sprintf(`%dth`, i)
+	},
+	`cardinal`: func(i int) string {
+		if i < len(cardinals) {
+			return cardinals[i]
+		}
+		return fmt.Sprint(i)
+	},
+	`apply`: func(m Monad, n int, fn, r string) Apply {
+		return Apply{Monad: m, N: n, Fn: fn, R: r}
+	},
+	// tuple is "gs.Tuple2[A, B]" for n = 2.
+	`tuple`: func(n int) (string, error) {
+		if n > maxTuple {
+			return ``, fmt.Errorf(`gsgen: gs has no Tuple%d, the highest arity is %d`, n, maxTuple)
+		}
+		return fmt.Sprintf(`gs.Tuple%d[%s]`, n, list(n, 0, `%s`)), nil
+	},
+	// newTuple is "gs.T2[A, B]" for n = 2.
+	`newTuple`: func(n int) (string, error) {
+		if n > maxTuple {
+			return ``, fmt.Errorf(`gsgen: gs has no T%d, the highest arity is %d`, n, maxTuple)
+		}
+		return fmt.Sprintf(`gs.T%d[%s]`, n, list(n, 0, `%s`)), nil
+	},
+	// fail stops the template with an error.
+	`fail`: func(msg string) (string, error) {
+		return ``, errors.New(msg)
+	},
+}
+
+// Config selects a template and the arities to generate.
+type Config struct {
+	Template string // Template is a builtin template name or a template file.
+	Pkg      string
+	Min, Max int
+	Monad    string
+}
+
+// Generate executes the template of cfg and returns gofmt-ed source.
+func Generate(cfg Config) ([]byte, error) {
+	if cfg.Min < 2 || cfg.Min > cfg.Max {
+		return nil, fmt.Errorf(`gsgen: invalid arities %d..%d`, cfg.Min, cfg.Max)
+	}
+	if cfg.Max >= len(letters) {
+		return nil, fmt.Errorf(`gsgen: arity %d exceeds %d`, cfg.Max, len(letters)-1)
+	}
+
+	text, err := builtin.ReadFile(`templates/` + cfg.Template + `.tmpl`)
+	if err != nil {
+		if text, err = os.ReadFile(cfg.Template); err != nil {
+			return nil, fmt.Errorf(`gsgen: template %q: %w`, cfg.Template, err)
+		}
+	}
+
+	data := Data{Pkg: cfg.Pkg}
+	for n := cfg.Min; n <= cfg.Max; n++ {
+		data.Arities = append(data.Arities, n)
+	}
+	if cfg.Monad != `` {
+		m, ok := monads[cfg.Monad]
+		if !ok {
+			return nil, fmt.Errorf(`gsgen: unknown monad %q`, cfg.Monad)
+		}
+		data.Monad = m
+	}
+
+	t, err := template.New(cfg.Template).Funcs(funcs).Parse(string(text))
+	if err != nil {
+		return nil, err
+	}
+	if t, err = t.ParseFS(builtin, `templates/*.inc`); err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	if err := t.Execute(buf, data); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,150 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerated checks the generated files of this module are up to date.
+func TestGenerated(t *testing.T) {
+	tests := []struct {
+		file string
+		cfg  Config
+	}{
+		{`arity_gen.go`, Config{Template: `arity`, Pkg: `goscala`, Min: 2, Max: 8}},
+		{`compose_gen.go`, Config{Template: `compose`, Pkg: `goscala`, Min: 3, Max: 8}},
+		{`typeclass/tuple_gen.go`, Config{Template: `tupleclass`, Pkg: `typeclass`, Min: 2, Max: 8}},
+		{`opt/map_gen.go`, Config{Template: `mapn`, Pkg: `opt`, Min: 2, Max: 8, Monad: `option`}},
+		{`try/map_gen.go`, Config{Template: `mapn`, Pkg: `try`, Min: 2, Max: 8, Monad: `try`}},
+		{`either/map_gen.go`, Config{Template: `mapn`, Pkg: `either`, Min: 2, Max: 8, Monad: `either`}},
+		{`validated/map_gen.go`, Config{Template: `mapn`, Pkg: `validated`, Min: 2, Max: 8, Monad: `validated`}},
+		{`opt/zip_gen.go`, Config{Template: `zip`, Pkg: `opt`, Min: 2, Max: 8, Monad: `option`}},
+		{`try/zip_gen.go`, Config{Template: `zip`, Pkg: `try`, Min: 2, Max: 8, Monad: `try`}},
+		{`either/zip_gen.go`, Config{Template: `zip`, Pkg: `either`, Min: 2, Max: 8, Monad: `either`}},
+		{`validated/zip_gen.go`, Config{Template: `zip`, Pkg: `validated`, Min: 2, Max: 8, Monad: `validated`}},
+		{`opt/for_gen.go`, Config{Template: `for`, Pkg: `opt`, Min: 2, Max: 6, Monad: `option`}},
+		{`try/for_gen.go`, Config{Template: `for`, Pkg: `try`, Min: 2, Max: 6, Monad: `try`}},
+		{`either/for_gen.go`, Config{Template: `for`, Pkg: `either`, Min: 2, Max: 6, Monad: `either`}},
+	}
+
+	for _, tt := range tests {
+		want, err := os.ReadFile(filepath.Join(`..`, `..`, tt.file))
+		assert.Nil(t, err)
+
+		got, err := Generate(tt.cfg)
+		assert.Nil(t, err)
+		assert.Equal(t, string(want), string(got), `%s is out of date, run go generate ./...`, tt.file)
+	}
+}
+
+func TestGenerateHigherArity(t *testing.T) {
+	src, err := Generate(Config{Template: `arity`, Pkg: `x`, Min: 10, Max: 12})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), `func Currying12[A, B, C, D, E, F, G, H, I, J, K, M, R any]`)
+	assert.Contains(t, string(src), `func Apply11of12[`)
+	assert.NotContains(t, string(src), `Currying9[`)
+
+	src, err = Generate(Config{Template: `compose`, Pkg: `x`, Min: 2, Max: 2})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), `func FuncAndThen2[A, B, C any](f1 func(A) B, f2 func(B) C) func(A) C {`)
+}
+
+// TestGenerateCompiles builds generated code in a package of another module,
+// at arities that the library itself does not generate.
+func TestGenerateCompiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip(`builds a module`)
+	}
+	gobin, err := exec.LookPath(`go`)
+	if err != nil {
+		t.Skip(`go is not in PATH`)
+	}
+	root, err := filepath.Abs(filepath.Join(`..`, `..`))
+	assert.Nil(t, err)
+
+	dir := t.TempDir()
+	mod := "module userpkg\n\ngo 1.21\n\nrequire github.com/kigichang/goscala v0.0.0\n\nreplace github.com/kigichang/goscala => " + root + "\n"
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, `go.mod`), []byte(mod), 0644))
+
+	tests := []Config{
+		{Template: `arity`, Min: 9, Max: 10},
+		{Template: `compose`, Min: 9, Max: 10},
+		{Template: `tupleclass`, Min: 2, Max: 8},
+		{Template: `mapn`, Min: 9, Max: 10, Monad: `option`},
+		{Template: `mapn`, Min: 3, Max: 3, Monad: `try`},
+		{Template: `mapn`, Min: 9, Max: 9, Monad: `either`},
+		{Template: `mapn`, Min: 9, Max: 9, Monad: `validated`},
+		{Template: `zip`, Min: 5, Max: 8, Monad: `option`},
+		{Template: `zip`, Min: 8, Max: 8, Monad: `try`},
+		{Template: `zip`, Min: 2, Max: 3, Monad: `either`},
+		{Template: `zip`, Min: 4, Max: 4, Monad: `validated`},
+		{Template: `for`, Min: 2, Max: 8, Monad: `option`},
+		{Template: `for`, Min: 2, Max: 8, Monad: `try`},
+		{Template: `for`, Min: 2, Max: 10, Monad: `either`},
+	}
+	for i, cfg := range tests {
+		cfg.Pkg = fmt.Sprintf(`p%d`, i)
+		src, err := Generate(cfg)
+		if !assert.Nil(t, err, `%+v`, cfg) {
+			continue
+		}
+		assert.Nil(t, os.Mkdir(filepath.Join(dir, cfg.Pkg), 0755))
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, cfg.Pkg, `gen.go`), src, 0644))
+	}
+
+	cmd := exec.Command(gobin, `vet`, `./...`)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), `GOFLAGS=-mod=mod`, `GOPROXY=off`)
+	out, err := cmd.CombinedOutput()
+	assert.Nil(t, err, string(out))
+}
+
+func TestGenerateTemplateFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), `slice.tmpl`)
+	text := `package {{.Pkg}}
+{{range $n := .Arities}}
+func Slice{{$n}}({{list $n 0 "v%d int"}}) []int {
+	return []int{ {{- list $n 0 "v%d" -}} }
+}
+{{end}}`
+	assert.Nil(t, os.WriteFile(file, []byte(text), 0644))
+
+	src, err := Generate(Config{Template: file, Pkg: `x`, Min: 2, Max: 3})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "func Slice3(v1 int, v2 int, v3 int) []int {\n\treturn []int{v1, v2, v3}\n}")
+}
+
+func TestGenerateErrors(t *testing.T) {
+	_, err := Generate(Config{Template: `arity`, Min: 3, Max: 2})
+	assert.NotNil(t, err)
+
+	_, err = Generate(Config{Template: `arity`, Min: 2, Max: 24})
+	assert.NotNil(t, err)
+
+	_, err = Generate(Config{Template: `nonexistent`, Min: 2, Max: 3})
+	assert.NotNil(t, err)
+
+	_, err = Generate(Config{Template: `mapn`, Min: 2, Max: 3, Monad: `list`})
+	assert.NotNil(t, err)
+
+	_, err = Generate(Config{Template: `for`, Min: 2, Max: 3, Monad: `validated`})
+	assert.NotNil(t, err)
+
+	_, err = Generate(Config{Template: `for`, Min: 3, Max: 4, Monad: `option`})
+	assert.NotNil(t, err)
+
+	_, err = Generate(Config{Template: `zip`, Min: 2, Max: 9, Monad: `option`})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), `gs has no Tuple9, the highest arity is 8`)
+	}
+}
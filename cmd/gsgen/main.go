@@ -0,0 +1,63 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Gsgen generates arity-indexed helpers from templates. It is meant for go:generate:
+//
+//	//go:generate go run github.com/kigichang/goscala/cmd/gsgen -t arity -max 8 -o arity_gen.go
+//
+// The builtin templates are:
+//
+//	arity       TupleN, CurryingN, UncurryingN, TupledN, UntupledN, FlipN and ApplyXofN
+//	compose     FuncComposeN and FuncAndThenN
+//	mapn        MapN of a monad, selected by -monad option, try, either or validated
+//	zip         Zip and ZipN of a monad, returning gs.TupleN
+//	for         ForN, BindN and YieldN for-comprehensions of option, try or either;
+//	            For1 and Bind1 are always generated, so -min must be 2
+//	tupleclass  TupleNEq and TupleNOrd of typeclass
+//
+// The output depends only on gs and typeclass, so it can be generated into any
+// package at any arity, such as Map9 to Map12 of Option:
+//
+//	//go:generate go run github.com/kigichang/goscala/cmd/gsgen -t mapn -monad option -min 9 -max 12 -o map_gen.go
+//
+// Templates that use gs.TupleN, which are zip, tupleclass and for of try, are limited
+// to the arities of gs, up to 8.
+//
+// Any other -t is read as a template file. Templates get the package name as .Pkg,
+// the arities as .Arities and the monad as .Monad.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	cfg := Config{}
+	flag.StringVar(&cfg.Template, `t`, `arity`, `builtin template name or template file`)
+	flag.StringVar(&cfg.Pkg, `pkg`, os.Getenv(`GOPACKAGE`), `package name`)
+	flag.IntVar(&cfg.Min, `min`, 2, `min arity`)
+	flag.IntVar(&cfg.Max, `max`, 8, `max arity`)
+	flag.StringVar(&cfg.Monad, `monad`, ``, `monad of the mapn, zip and for templates: option, try, either or validated`)
+	out := flag.String(`o`, ``, `output file, stdout if empty`)
+	flag.Parse()
+
+	src, err := Generate(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *out == `` {
+		os.Stdout.Write(src)
+		return
+	}
+
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
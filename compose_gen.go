@@ -0,0 +1,92 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gsgen -t compose; DO NOT EDIT.
+
+package goscala
+
+// FuncCompose3 composes 3 functions. f3 is applied first and f1 last.
+func FuncCompose3[A, B, C, D any](f1 func(C) D, f2 func(B) C, f3 func(A) B) func(A) D {
+	return func(v A) D {
+		return f1(f2(f3(v)))
+	}
+}
+
+// FuncAndThen3 chains 3 functions. f1 is applied first and f3 last.
+func FuncAndThen3[A, B, C, D any](f1 func(A) B, f2 func(B) C, f3 func(C) D) func(A) D {
+	return func(v A) D {
+		return f3(f2(f1(v)))
+	}
+}
+
+// FuncCompose4 composes 4 functions. f4 is applied first and f1 last.
+func FuncCompose4[A, B, C, D, E any](f1 func(D) E, f2 func(C) D, f3 func(B) C, f4 func(A) B) func(A) E {
+	return func(v A) E {
+		return f1(f2(f3(f4(v))))
+	}
+}
+
+// FuncAndThen4 chains 4 functions. f1 is applied first and f4 last.
+func FuncAndThen4[A, B, C, D, E any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E) func(A) E {
+	return func(v A) E {
+		return f4(f3(f2(f1(v))))
+	}
+}
+
+// FuncCompose5 composes 5 functions. f5 is applied first and f1 last.
+func FuncCompose5[A, B, C, D, E, F any](f1 func(E) F, f2 func(D) E, f3 func(C) D, f4 func(B) C, f5 func(A) B) func(A) F {
+	return func(v A) F {
+		return f1(f2(f3(f4(f5(v)))))
+	}
+}
+
+// FuncAndThen5 chains 5 functions. f1 is applied first and f5 last.
+func FuncAndThen5[A, B, C, D, E, F any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F) func(A) F {
+	return func(v A) F {
+		return f5(f4(f3(f2(f1(v)))))
+	}
+}
+
+// FuncCompose6 composes 6 functions. f6 is applied first and f1 last.
+func FuncCompose6[A, B, C, D, E, F, G any](f1 func(F) G, f2 func(E) F, f3 func(D) E, f4 func(C) D, f5 func(B) C, f6 func(A) B) func(A) G {
+	return func(v A) G {
+		return f1(f2(f3(f4(f5(f6(v))))))
+	}
+}
+
+// FuncAndThen6 chains 6 functions. f1 is applied first and f6 last.
+func FuncAndThen6[A, B, C, D, E, F, G any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G) func(A) G {
+	return func(v A) G {
+		return f6(f5(f4(f3(f2(f1(v))))))
+	}
+}
+
+// FuncCompose7 composes 7 functions. f7 is applied first and f1 last.
+func FuncCompose7[A, B, C, D, E, F, G, H any](f1 func(G) H, f2 func(F) G, f3 func(E) F, f4 func(D) E, f5 func(C) D, f6 func(B) C, f7 func(A) B) func(A) H {
+	return func(v A) H {
+		return f1(f2(f3(f4(f5(f6(f7(v)))))))
+	}
+}
+
+// FuncAndThen7 chains 7 functions. f1 is applied first and f7 last.
+func FuncAndThen7[A, B, C, D, E, F, G, H any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H) func(A) H {
+	return func(v A) H {
+		return f7(f6(f5(f4(f3(f2(f1(v)))))))
+	}
+}
+
+// FuncCompose8 composes 8 functions. f8 is applied first and f1 last.
+func FuncCompose8[A, B, C, D, E, F, G, H, I any](f1 func(H) I, f2 func(G) H, f3 func(F) G, f4 func(E) F, f5 func(D) E, f6 func(C) D, f7 func(B) C, f8 func(A) B) func(A) I {
+	return func(v A) I {
+		return f1(f2(f3(f4(f5(f6(f7(f8(v))))))))
+	}
+}
+
+// FuncAndThen8 chains 8 functions. f1 is applied first and f8 last.
+func FuncAndThen8[A, B, C, D, E, F, G, H, I any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H, f8 func(H) I) func(A) I {
+	return func(v A) I {
+		return f8(f7(f6(f5(f4(f3(f2(f1(v))))))))
+	}
+}
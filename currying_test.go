@@ -0,0 +1,55 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package goscala_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/stretchr/testify/assert"
+)
+
+func sum3(a int, b string, c float64) string {
+	return fmt.Sprintf("%d%s%.1f", a, b, c)
+}
+
+func TestCurrying(t *testing.T) {
+	assert.Equal(t, "1a2.0", gs.Currying3(sum3)(1)("a")(2))
+	assert.Equal(t, "1a2.0", gs.Uncurrying3(gs.Currying3(sum3))(1, "a", 2))
+
+	sum8 := func(a, b, c, d, e, f, g, h int) int { return a + b + c + d + e + f + g + h }
+	assert.Equal(t, 36, gs.Currying8(sum8)(1)(2)(3)(4)(5)(6)(7)(8))
+	assert.Equal(t, 36, gs.Uncurrying8(gs.Currying8(sum8))(1, 2, 3, 4, 5, 6, 7, 8))
+}
+
+func TestTupled(t *testing.T) {
+	tp := gs.T3(1, "a", 2.0)
+	assert.Equal(t, 1, tp.V1())
+	assert.Equal(t, "a", tp.V2())
+	assert.Equal(t, 2.0, tp.V3())
+
+	assert.Equal(t, "1a2.0", gs.Tupled3(sum3)(tp))
+	assert.Equal(t, "1a2.0", gs.Untupled3(gs.Tupled3(sum3))(1, "a", 2))
+}
+
+func TestFlipAndApply(t *testing.T) {
+	assert.Equal(t, "1a2.0", gs.Flip3(sum3)("a", 1, 2))
+	assert.Equal(t, 1, gs.Flip2(func(a, b int) int { return a - b })(1, 2))
+
+	assert.Equal(t, "1a2.0", gs.Apply1of3(sum3, 1)("a", 2))
+	assert.Equal(t, "1a2.0", gs.Apply2of3(sum3, "a")(1, 2))
+	assert.Equal(t, "1a2.0", gs.Apply3of3(sum3, 2)(1, "a"))
+}
+
+func TestFuncComposeN(t *testing.T) {
+	inc := func(v int) int { return v + 1 }
+	double := func(v int) int { return v * 2 }
+
+	assert.Equal(t, "6", gs.FuncCompose3(strconv.Itoa, double, inc)(2))
+	assert.Equal(t, "5", gs.FuncAndThen3(double, inc, strconv.Itoa)(2))
+}
@@ -6,11 +6,17 @@
 package goscala
 
 import (
+	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
 type Either[L, R any] interface {
 	fmt.Stringer
+	fmt.Formatter
+	fmt.GoStringer
+	slog.LogValuer
+	json.Marshaler
 	Fetcher[R]
 	IsRight() bool
 	IsLeft() bool
@@ -27,7 +33,7 @@ type Either[L, R any] interface {
 	Swap() Either[R, L]
 	Option() Option[R]
 	Slice() Slice[R]
-	// Try() Try[R]
+	Try() Try[R]
 }
 
 type either[L, R any] struct {
@@ -39,10 +45,30 @@ type either[L, R any] struct {
 var _ Either[int, string] = &either[int, string]{}
 
 func (e *either[L, R]) String() string {
+	return fmt.Sprintf(`%v`, e)
+}
+
+func (e *either[L, R]) GoString() string {
+	return fmt.Sprintf(`%#v`, e)
+}
+
+// Format prints Right(v) or Left(v) for %v, adds the types for %+v and
+// prints Go syntax for %#v.
+func (e *either[L, R]) Format(f fmt.State, verb rune) {
+	types := TypeName[L]() + `, ` + TypeName[R]()
+	if e.right {
+		FormatApply(f, verb, `gs`, `Right`, types, e.rv)
+		return
+	}
+	FormatApply(f, verb, `gs`, `Left`, types, e.lv)
+}
+
+// LogValue logs status=right or status=left with the value.
+func (e *either[L, R]) LogValue() slog.Value {
 	if e.right {
-		return fmt.Sprintf(`Right(%v)`, e.rv)
+		return slog.GroupValue(slog.String(`status`, `right`), slog.Any(`value`, e.rv))
 	}
-	return fmt.Sprintf(`Left(%v)`, e.lv)
+	return slog.GroupValue(slog.String(`status`, `left`), slog.Any(`value`, e.lv))
 }
 
 func (e *either[L, R]) Fetch() (R, bool) {
@@ -50,7 +76,10 @@ func (e *either[L, R]) Fetch() (R, bool) {
 }
 
 func (e *either[L, R]) FetchErr() (R, error) {
-	return e.rv, Cond(e.right, nil, ErrLeft)
+	if e.right {
+		return e.rv, nil
+	}
+	return e.rv, LeftErr(e.lv)
 }
 
 func (e *either[L, R]) fetchAll() (R, L) {
@@ -69,14 +98,14 @@ func (e *either[L, R]) Left() L {
 	if !e.right {
 		return e.lv
 	}
-	panic(fmt.Errorf("can not get left value from %v", e))
+	panic(NoSuchElement[L](`Either`))
 }
 
 func (e *either[L, R]) Right() R {
 	if e.right {
 		return e.rv
 	}
-	panic(fmt.Errorf("can not get right value from %v", e))
+	panic(NoSuchElement[R](`Either`))
 }
 
 func (e *either[L, R]) Get() R {
@@ -129,24 +158,62 @@ func (e *either[L, R]) Slice() Slice[R] {
 	)(e.Fetch)
 }
 
-//func (e *either[L, R]) try() *try[R] {
-//	if e.right {
-//		return success[R](e.rv)
-//	}
-//
-//	var x interface{} = e.lv
-//	switch v := x.(type) {
-//	case error:
-//		return failure[R](v)
-//	default:
-//		return failure[R](fmt.Errorf(`%v`, v))
-//	}
-//
-//}
-//
-//func (e *either[L, R]) Try() Try[R] {
-//	return e.try()
-//}
+type eitherJSON struct {
+	Left  json.RawMessage `json:"left,omitempty"`
+	Right json.RawMessage `json:"right,omitempty"`
+}
+
+// MarshalJSON encodes Left as {"left": v} and Right as {"right": v}.
+func (e *either[L, R]) MarshalJSON() ([]byte, error) {
+	if e.right {
+		return json.Marshal(map[string]R{"right": e.rv})
+	}
+	return json.Marshal(map[string]L{"left": e.lv})
+}
+
+// UnmarshalJSON decodes an object holding exactly one of "left" or "right".
+func (e *either[L, R]) UnmarshalJSON(data []byte) error {
+	var x eitherJSON
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+
+	switch {
+	case x.Left != nil && x.Right == nil:
+		var v L
+		if err := json.Unmarshal(x.Left, &v); err != nil {
+			return err
+		}
+		*e = either[L, R]{right: false, lv: v}
+	case x.Right != nil && x.Left == nil:
+		var v R
+		if err := json.Unmarshal(x.Right, &v); err != nil {
+			return err
+		}
+		*e = either[L, R]{right: true, rv: v}
+	default:
+		return fmt.Errorf(`either: expect exactly one of "left" or "right" in %s`, data)
+	}
+	return nil
+}
+
+func (e *either[L, R]) try() *try[R] {
+	if e.right {
+		return success[R](e.rv)
+	}
+
+	var x interface{} = e.lv
+	if err, ok := x.(error); ok {
+		return failure[R](err)
+	}
+	return failure[R](LeftErr(e.lv))
+}
+
+// Try returns Success of the right value, or Failure of the left value.
+// A left error is kept as is; any other left value is wrapped in LeftError.
+func (e *either[L, R]) Try() Try[R] {
+	return e.try()
+}
 
 func Left[L, R any](v L) Either[L, R] {
 	return left[L, R](v)
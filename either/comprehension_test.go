@@ -0,0 +1,42 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package either_test
+
+import (
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/either"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComprehension(t *testing.T) {
+	positive := func(v int) gs.Either[string, int] {
+		return either.Cond(func() bool { return v > 0 }, "not positive", v)
+	}
+
+	div := func(x, y int) gs.Either[string, int] {
+		f2 := either.Bind2(either.Bind1(positive(x)), func(int) gs.Either[string, int] { return positive(y) })
+		f3 := either.Bind3(
+			f2.If(func(a, b int) bool { return a%b == 0 }, "not divisible"),
+			func(a, b int) gs.Either[string, int] { return gs.Right[string](a / b) },
+		)
+		return either.Yield3(f3, func(_, _, c int) int { return c })
+	}
+
+	assert.Equal(t, 3, div(6, 2).Right())
+	assert.Equal(t, "not divisible", div(7, 2).Left())
+	assert.Equal(t, "not positive", div(-6, 2).Left())
+	assert.Equal(t, "not positive", div(6, -2).Left())
+
+	f6 := either.Bind6(either.Bind5(either.Bind4(either.Bind3(either.Bind2(either.Bind1(positive(1)),
+		func(a int) gs.Either[string, int] { return positive(a + 1) }),
+		func(a, b int) gs.Either[string, int] { return positive(b + 1) }),
+		func(a, b, c int) gs.Either[string, int] { return positive(c + 1) }),
+		func(a, b, c, d int) gs.Either[string, int] { return positive(d + 1) }),
+		func(a, b, c, d, e int) gs.Either[string, int] { return positive(-e) })
+	assert.Equal(t, "not positive", either.Yield6(f6, func(a, b, c, d, e, f int) int { return f }).Left())
+}
@@ -5,7 +5,13 @@
 
 package either
 
+//go:generate go run ../cmd/gsgen -t mapn -monad either -max 8 -o map_gen.go
+//go:generate go run ../cmd/gsgen -t zip -monad either -max 8 -o zip_gen.go
+//go:generate go run ../cmd/gsgen -t for -monad either -max 6 -o for_gen.go
+
 import (
+	"encoding/json"
+
 	gs "github.com/kigichang/goscala"
 )
 
@@ -54,3 +60,159 @@ func Map[L, R, R1 any](e gs.Either[L, R], fn func(R) R1) gs.Either[L, R1] {
 		gs.FuncUnitAndThen(e.Left, gs.Left[L, R1]),
 	)(e.Fetch)
 }
+
+// Unmarshal decodes an object holding exactly one of "left" or "right".
+func Unmarshal[L, R any](data []byte) (gs.Either[L, R], error) {
+	var z L
+	e := gs.Left[L, R](z)
+	if err := json.Unmarshal(data, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func fetchLeft[L, R any](e gs.Either[L, R]) func() (L, bool) {
+	return e.Swap().Fetch
+}
+
+// MapLeft applies fn to the left value, or returns the right value unchanged.
+func MapLeft[L, R, L1 any](e gs.Either[L, R], fn func(L) L1) gs.Either[L1, R] {
+	return gs.Partial(
+		gs.FuncAndThen(fn, gs.Left[L1, R]),
+		gs.FuncUnitAndThen(e.Right, gs.Right[L1, R]),
+	)(fetchLeft(e))
+}
+
+// FlatMapLeft binds fn to the left value, or returns the right value unchanged.
+func FlatMapLeft[L, R, L1 any](e gs.Either[L, R], fn func(L) gs.Either[L1, R]) gs.Either[L1, R] {
+	return gs.Partial(
+		fn,
+		gs.FuncUnitAndThen(e.Right, gs.Right[L1, R]),
+	)(fetchLeft(e))
+}
+
+// BiMap applies left to the left value or right to the right value.
+func BiMap[L, R, L1, R1 any](e gs.Either[L, R], left func(L) L1, right func(R) R1) gs.Either[L1, R1] {
+	return Fold(
+		e,
+		gs.FuncAndThen(left, gs.Left[L1, R1]),
+		gs.FuncAndThen(right, gs.Right[L1, R1]),
+	)
+}
+
+// LeftOrElse returns the left value, or z if e is Right.
+func LeftOrElse[L, R any](e gs.Either[L, R], z L) L {
+	return gs.Default(z)(fetchLeft(e))
+}
+
+// Merge returns the value of e whichever side it is on.
+func Merge[T any](e gs.Either[T, T]) T {
+	return Fold(e, gs.Id[T], gs.Id[T])
+}
+
+// LeftProjection is a left-biased view of Either.
+type LeftProjection[L, R any] struct {
+	e gs.Either[L, R]
+}
+
+// ProjectLeft returns a left-biased view of e.
+func ProjectLeft[L, R any](e gs.Either[L, R]) LeftProjection[L, R] {
+	return LeftProjection[L, R]{e: e}
+}
+
+func (p LeftProjection[L, R]) Either() gs.Either[L, R] {
+	return p.e
+}
+
+func (p LeftProjection[L, R]) Get() L {
+	return p.e.Left()
+}
+
+func (p LeftProjection[L, R]) Exists(fn func(L) bool) bool {
+	return gs.Partial(fn, gs.False)(fetchLeft(p.e))
+}
+
+// Filter returns Some of the underlying Either if it is Left and satisfies fn, or None.
+func (p LeftProjection[L, R]) Filter(fn func(L) bool) gs.Option[gs.Either[L, R]] {
+	return gs.Partial(
+		gs.Predict(
+			gs.FuncAndThen(gs.Left[L, R], gs.Some[gs.Either[L, R]]),
+			gs.None[gs.Either[L, R]],
+		)(fn),
+		gs.None[gs.Either[L, R]],
+	)(fetchLeft(p.e))
+}
+
+func (p LeftProjection[L, R]) Forall(fn func(L) bool) bool {
+	return gs.Partial(fn, gs.True)(fetchLeft(p.e))
+}
+
+func (p LeftProjection[L, R]) Foreach(fn func(L)) {
+	gs.Partial(gs.UnitWrap(fn), gs.Unit)(fetchLeft(p.e))
+}
+
+func (p LeftProjection[L, R]) GetOrElse(z L) L {
+	return LeftOrElse(p.e, z)
+}
+
+func (p LeftProjection[L, R]) Option() gs.Option[L] {
+	return gs.Partial(gs.Some[L], gs.None[L])(fetchLeft(p.e))
+}
+
+// RightProjection is a right-biased view of Either.
+type RightProjection[L, R any] struct {
+	e gs.Either[L, R]
+}
+
+// ProjectRight returns a right-biased view of e.
+func ProjectRight[L, R any](e gs.Either[L, R]) RightProjection[L, R] {
+	return RightProjection[L, R]{e: e}
+}
+
+func (p RightProjection[L, R]) Either() gs.Either[L, R] {
+	return p.e
+}
+
+func (p RightProjection[L, R]) Get() R {
+	return p.e.Right()
+}
+
+func (p RightProjection[L, R]) Exists(fn func(R) bool) bool {
+	return p.e.Exists(fn)
+}
+
+// Filter returns Some of the underlying Either if it is Right and satisfies fn, or None.
+func (p RightProjection[L, R]) Filter(fn func(R) bool) gs.Option[gs.Either[L, R]] {
+	return gs.Partial(
+		gs.Predict(
+			gs.FuncAndThen(gs.Right[L, R], gs.Some[gs.Either[L, R]]),
+			gs.None[gs.Either[L, R]],
+		)(fn),
+		gs.None[gs.Either[L, R]],
+	)(p.e.Fetch)
+}
+
+func (p RightProjection[L, R]) Forall(fn func(R) bool) bool {
+	return p.e.Forall(fn)
+}
+
+func (p RightProjection[L, R]) Foreach(fn func(R)) {
+	p.e.Foreach(fn)
+}
+
+func (p RightProjection[L, R]) GetOrElse(z R) R {
+	return p.e.GetOrElse(z)
+}
+
+func (p RightProjection[L, R]) Option() gs.Option[R] {
+	return p.e.Option()
+}
+
+// Try returns Success of the right value, or Failure of the left value mapped by fn.
+func Try[L, R any](e gs.Either[L, R], fn func(L) error) gs.Try[R] {
+	return Fold(
+		e,
+		gs.FuncAndThen(fn, gs.Failure[R]),
+		gs.Success[R],
+	)
+}
@@ -6,11 +6,13 @@
 package either_test
 
 import (
+	"encoding/json"
 	"strconv"
 	"testing"
 
 	gs "github.com/kigichang/goscala"
 	"github.com/kigichang/goscala/either"
+	"github.com/kigichang/goscala/try"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -122,3 +124,155 @@ func TestMap(t *testing.T) {
 	assert.Equal(t, true, e.IsLeft())
 	assert.Equal(t, 12, e.Left())
 }
+
+func TestUnmarshal(t *testing.T) {
+	e, err := either.Unmarshal[string, int]([]byte(`{"right":1}`))
+	assert.Nil(t, err)
+	assert.True(t, e.IsRight())
+	assert.Equal(t, 1, e.Right())
+
+	e, err = either.Unmarshal[string, int]([]byte(`{"left":"abc"}`))
+	assert.Nil(t, err)
+	assert.True(t, e.IsLeft())
+	assert.Equal(t, "abc", e.Left())
+
+	e, err = either.Unmarshal[string, int]([]byte(`{"left":null}`))
+	assert.Nil(t, err)
+	assert.True(t, e.IsLeft())
+	assert.Equal(t, "", e.Left())
+
+	_, err = either.Unmarshal[string, int]([]byte(`{"left":"abc","right":1}`))
+	assert.NotNil(t, err)
+
+	_, err = either.Unmarshal[string, int]([]byte(`{}`))
+	assert.NotNil(t, err)
+
+	_, err = either.Unmarshal[string, int]([]byte(`{"right":"abc"}`))
+	assert.NotNil(t, err)
+
+	x := gs.Left[string, int]("abc")
+	data, err := json.Marshal(x)
+	assert.Nil(t, err)
+	y, err := either.Unmarshal[string, int](data)
+	assert.Nil(t, err)
+	assert.Equal(t, x.String(), y.String())
+}
+
+func TestMapLeft(t *testing.T) {
+	e := either.MapLeft(gs.Left[int, string](12), strconv.Itoa)
+	assert.True(t, e.IsLeft())
+	assert.Equal(t, "12", e.Left())
+
+	e = either.MapLeft(gs.Right[int, string]("abc"), strconv.Itoa)
+	assert.True(t, e.IsRight())
+	assert.Equal(t, "abc", e.Right())
+}
+
+func TestFlatMapLeft(t *testing.T) {
+	fn := func(v int) gs.Either[string, string] {
+		if v > 0 {
+			return gs.Left[string, string](strconv.Itoa(v))
+		}
+		return gs.Right[string, string]("recovered")
+	}
+
+	e := either.FlatMapLeft(gs.Left[int, string](12), fn)
+	assert.Equal(t, "12", e.Left())
+
+	e = either.FlatMapLeft(gs.Left[int, string](-1), fn)
+	assert.Equal(t, "recovered", e.Right())
+
+	e = either.FlatMapLeft(gs.Right[int, string]("abc"), fn)
+	assert.Equal(t, "abc", e.Right())
+}
+
+func TestBiMap(t *testing.T) {
+	double := func(v int) int { return v * 2 }
+
+	e := either.BiMap(gs.Left[int, int](1), strconv.Itoa, double)
+	assert.Equal(t, "1", e.Left())
+
+	e = either.BiMap(gs.Right[int, int](1), strconv.Itoa, double)
+	assert.Equal(t, 2, e.Right())
+}
+
+func TestLeftOrElseAndMerge(t *testing.T) {
+	assert.Equal(t, 1, either.LeftOrElse(gs.Left[int, string](1), 0))
+	assert.Equal(t, 0, either.LeftOrElse(gs.Right[int, string]("abc"), 0))
+
+	assert.Equal(t, 1, either.Merge(gs.Left[int, int](1)))
+	assert.Equal(t, 2, either.Merge(gs.Right[int, int](2)))
+}
+
+func TestProjection(t *testing.T) {
+	/*
+	   Left(12).left.exists(_ > 10)   // true
+	   Left(12).left.forall(_ > 10)   // true
+	   Right(12).left.forall(_ > 10)  // true
+	   Left(12).left.getOrElse(17)    // 12
+	   Right(12).left.getOrElse(17)   // 17
+	*/
+	p := func(v int) bool { return v > 10 }
+
+	l := either.ProjectLeft(gs.Left[int, int](12))
+	r := either.ProjectLeft(gs.Right[int, int](12))
+
+	assert.True(t, l.Exists(p))
+	assert.False(t, r.Exists(p))
+	assert.True(t, l.Forall(p))
+	assert.True(t, r.Forall(p))
+	assert.Equal(t, 12, l.Get())
+	assert.Panics(t, func() { r.Get() })
+	assert.Equal(t, 12, l.GetOrElse(17))
+	assert.Equal(t, 17, r.GetOrElse(17))
+	assert.Equal(t, 12, l.Option().Get())
+	assert.True(t, r.Option().IsEmpty())
+	assert.Equal(t, 12, l.Filter(p).Get().Left())
+	assert.True(t, l.Filter(func(v int) bool { return v > 20 }).IsEmpty())
+	assert.True(t, r.Filter(p).IsEmpty())
+
+	sum := 0
+	l.Foreach(func(v int) { sum += v })
+	r.Foreach(func(v int) { sum += v })
+	assert.Equal(t, 12, sum)
+
+	rl := either.ProjectRight(gs.Left[int, int](12))
+	rr := either.ProjectRight(gs.Right[int, int](12))
+
+	assert.False(t, rl.Exists(p))
+	assert.True(t, rr.Exists(p))
+	assert.True(t, rl.Forall(p))
+	assert.Equal(t, 12, rr.Get())
+	assert.Equal(t, 17, rl.GetOrElse(17))
+	assert.Equal(t, 12, rr.Filter(p).Get().Right())
+	assert.True(t, rl.Filter(p).IsEmpty())
+	assert.True(t, rl.Option().IsEmpty())
+	assert.True(t, rr.Either().IsRight())
+}
+
+type codeError struct {
+	code int
+}
+
+func (e *codeError) Error() string {
+	return "code " + strconv.Itoa(e.code)
+}
+
+func TestTry(t *testing.T) {
+	toErr := func(code int) error { return &codeError{code: code} }
+	toCode := func(err error) int { return err.(*codeError).code }
+
+	tr := either.Try(gs.Right[int, string]("abc"), toErr)
+	assert.Equal(t, "abc", tr.Get())
+
+	tr = either.Try(gs.Left[int, string](404), toErr)
+	assert.Equal(t, "code 404", tr.Failed().Error())
+
+	// round trip keeps the left value.
+	e := try.Either(tr, toCode)
+	assert.True(t, e.IsLeft())
+	assert.Equal(t, 404, e.Left())
+
+	e = try.Either(either.Try(gs.Right[int, string]("abc"), toErr), toCode)
+	assert.Equal(t, "abc", e.Right())
+}
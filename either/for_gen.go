@@ -0,0 +1,229 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gsgen -t for; DO NOT EDIT.
+
+package either
+
+import (
+	gs "github.com/kigichang/goscala"
+)
+
+// For1 is a for-comprehension after one step.
+type For1[L, A any] struct {
+	a    A
+	left L
+	ok   bool
+}
+
+// Bind1 starts a for-comprehension over Either. Each following BindN step receives
+// the values of all previous steps, and the chain stops at the first Left.
+func Bind1[L, A any](t gs.Either[L, A]) For1[L, A] {
+	if a, ok := t.Fetch(); ok {
+		return For1[L, A]{a: a, ok: true}
+	}
+	return For1[L, A]{left: t.Left()}
+}
+
+// If is a guard. The comprehension becomes Left of z if p does not hold.
+func (s For1[L, A]) If(p func(A) bool, z L) For1[L, A] {
+	if s.ok && !p(s.a) {
+		return For1[L, A]{left: z}
+	}
+	return s
+}
+
+// Yield1 ends the comprehension with the result of fn, or the first Left.
+func Yield1[L, A, R any](s For1[L, A], fn func(A) R) gs.Either[L, R] {
+	if !s.ok {
+		return gs.Left[L, R](s.left)
+	}
+	return gs.Right[L](fn(s.a))
+}
+
+// For2 is a for-comprehension after two steps.
+type For2[L, A, B any] struct {
+	a    A
+	b    B
+	left L
+	ok   bool
+}
+
+func Bind2[L, A, B any](s For1[L, A], fn func(A) gs.Either[L, B]) For2[L, A, B] {
+	if !s.ok {
+		return For2[L, A, B]{left: s.left}
+	}
+	x := fn(s.a)
+	if v, ok := x.Fetch(); ok {
+		return For2[L, A, B]{a: s.a, b: v, ok: true}
+	}
+	return For2[L, A, B]{left: x.Left()}
+}
+
+// If is a guard. The comprehension becomes Left of z if p does not hold.
+func (s For2[L, A, B]) If(p func(A, B) bool, z L) For2[L, A, B] {
+	if s.ok && !p(s.a, s.b) {
+		return For2[L, A, B]{left: z}
+	}
+	return s
+}
+
+// Yield2 ends the comprehension with the result of fn, or the first Left.
+func Yield2[L, A, B, R any](s For2[L, A, B], fn func(A, B) R) gs.Either[L, R] {
+	if !s.ok {
+		return gs.Left[L, R](s.left)
+	}
+	return gs.Right[L](fn(s.a, s.b))
+}
+
+// For3 is a for-comprehension after three steps.
+type For3[L, A, B, C any] struct {
+	a    A
+	b    B
+	c    C
+	left L
+	ok   bool
+}
+
+func Bind3[L, A, B, C any](s For2[L, A, B], fn func(A, B) gs.Either[L, C]) For3[L, A, B, C] {
+	if !s.ok {
+		return For3[L, A, B, C]{left: s.left}
+	}
+	x := fn(s.a, s.b)
+	if v, ok := x.Fetch(); ok {
+		return For3[L, A, B, C]{a: s.a, b: s.b, c: v, ok: true}
+	}
+	return For3[L, A, B, C]{left: x.Left()}
+}
+
+// If is a guard. The comprehension becomes Left of z if p does not hold.
+func (s For3[L, A, B, C]) If(p func(A, B, C) bool, z L) For3[L, A, B, C] {
+	if s.ok && !p(s.a, s.b, s.c) {
+		return For3[L, A, B, C]{left: z}
+	}
+	return s
+}
+
+// Yield3 ends the comprehension with the result of fn, or the first Left.
+func Yield3[L, A, B, C, R any](s For3[L, A, B, C], fn func(A, B, C) R) gs.Either[L, R] {
+	if !s.ok {
+		return gs.Left[L, R](s.left)
+	}
+	return gs.Right[L](fn(s.a, s.b, s.c))
+}
+
+// For4 is a for-comprehension after four steps.
+type For4[L, A, B, C, D any] struct {
+	a    A
+	b    B
+	c    C
+	d    D
+	left L
+	ok   bool
+}
+
+func Bind4[L, A, B, C, D any](s For3[L, A, B, C], fn func(A, B, C) gs.Either[L, D]) For4[L, A, B, C, D] {
+	if !s.ok {
+		return For4[L, A, B, C, D]{left: s.left}
+	}
+	x := fn(s.a, s.b, s.c)
+	if v, ok := x.Fetch(); ok {
+		return For4[L, A, B, C, D]{a: s.a, b: s.b, c: s.c, d: v, ok: true}
+	}
+	return For4[L, A, B, C, D]{left: x.Left()}
+}
+
+// If is a guard. The comprehension becomes Left of z if p does not hold.
+func (s For4[L, A, B, C, D]) If(p func(A, B, C, D) bool, z L) For4[L, A, B, C, D] {
+	if s.ok && !p(s.a, s.b, s.c, s.d) {
+		return For4[L, A, B, C, D]{left: z}
+	}
+	return s
+}
+
+// Yield4 ends the comprehension with the result of fn, or the first Left.
+func Yield4[L, A, B, C, D, R any](s For4[L, A, B, C, D], fn func(A, B, C, D) R) gs.Either[L, R] {
+	if !s.ok {
+		return gs.Left[L, R](s.left)
+	}
+	return gs.Right[L](fn(s.a, s.b, s.c, s.d))
+}
+
+// For5 is a for-comprehension after five steps.
+type For5[L, A, B, C, D, E any] struct {
+	a    A
+	b    B
+	c    C
+	d    D
+	e    E
+	left L
+	ok   bool
+}
+
+func Bind5[L, A, B, C, D, E any](s For4[L, A, B, C, D], fn func(A, B, C, D) gs.Either[L, E]) For5[L, A, B, C, D, E] {
+	if !s.ok {
+		return For5[L, A, B, C, D, E]{left: s.left}
+	}
+	x := fn(s.a, s.b, s.c, s.d)
+	if v, ok := x.Fetch(); ok {
+		return For5[L, A, B, C, D, E]{a: s.a, b: s.b, c: s.c, d: s.d, e: v, ok: true}
+	}
+	return For5[L, A, B, C, D, E]{left: x.Left()}
+}
+
+// If is a guard. The comprehension becomes Left of z if p does not hold.
+func (s For5[L, A, B, C, D, E]) If(p func(A, B, C, D, E) bool, z L) For5[L, A, B, C, D, E] {
+	if s.ok && !p(s.a, s.b, s.c, s.d, s.e) {
+		return For5[L, A, B, C, D, E]{left: z}
+	}
+	return s
+}
+
+// Yield5 ends the comprehension with the result of fn, or the first Left.
+func Yield5[L, A, B, C, D, E, R any](s For5[L, A, B, C, D, E], fn func(A, B, C, D, E) R) gs.Either[L, R] {
+	if !s.ok {
+		return gs.Left[L, R](s.left)
+	}
+	return gs.Right[L](fn(s.a, s.b, s.c, s.d, s.e))
+}
+
+// For6 is a for-comprehension after six steps.
+type For6[L, A, B, C, D, E, F any] struct {
+	a    A
+	b    B
+	c    C
+	d    D
+	e    E
+	f    F
+	left L
+	ok   bool
+}
+
+func Bind6[L, A, B, C, D, E, F any](s For5[L, A, B, C, D, E], fn func(A, B, C, D, E) gs.Either[L, F]) For6[L, A, B, C, D, E, F] {
+	if !s.ok {
+		return For6[L, A, B, C, D, E, F]{left: s.left}
+	}
+	x := fn(s.a, s.b, s.c, s.d, s.e)
+	if v, ok := x.Fetch(); ok {
+		return For6[L, A, B, C, D, E, F]{a: s.a, b: s.b, c: s.c, d: s.d, e: s.e, f: v, ok: true}
+	}
+	return For6[L, A, B, C, D, E, F]{left: x.Left()}
+}
+
+// If is a guard. The comprehension becomes Left of z if p does not hold.
+func (s For6[L, A, B, C, D, E, F]) If(p func(A, B, C, D, E, F) bool, z L) For6[L, A, B, C, D, E, F] {
+	if s.ok && !p(s.a, s.b, s.c, s.d, s.e, s.f) {
+		return For6[L, A, B, C, D, E, F]{left: z}
+	}
+	return s
+}
+
+// Yield6 ends the comprehension with the result of fn, or the first Left.
+func Yield6[L, A, B, C, D, E, F, R any](s For6[L, A, B, C, D, E, F], fn func(A, B, C, D, E, F) R) gs.Either[L, R] {
+	if !s.ok {
+		return gs.Left[L, R](s.left)
+	}
+	return gs.Right[L](fn(s.a, s.b, s.c, s.d, s.e, s.f))
+}
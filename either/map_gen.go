@@ -0,0 +1,187 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gsgen -t mapn; DO NOT EDIT.
+
+package either
+
+import (
+	gs "github.com/kigichang/goscala"
+)
+
+// Map2 applies fn to the values of v1 and v2, or returns the first Left.
+func Map2[L, A, B, R any](v1 gs.Either[L, A], v2 gs.Either[L, B], fn func(A, B) R) gs.Either[L, R] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.Left[L, R](v1.Left())
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.Left[L, R](v2.Left())
+	}
+	return gs.Right[L](fn(x1, x2))
+}
+
+// Map3 applies fn to the values of v1, v2 and v3, or returns the first Left.
+func Map3[L, A, B, C, R any](v1 gs.Either[L, A], v2 gs.Either[L, B], v3 gs.Either[L, C], fn func(A, B, C) R) gs.Either[L, R] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.Left[L, R](v1.Left())
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.Left[L, R](v2.Left())
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.Left[L, R](v3.Left())
+	}
+	return gs.Right[L](fn(x1, x2, x3))
+}
+
+// Map4 applies fn to the values of v1, v2, v3 and v4, or returns the first Left.
+func Map4[L, A, B, C, D, R any](v1 gs.Either[L, A], v2 gs.Either[L, B], v3 gs.Either[L, C], v4 gs.Either[L, D], fn func(A, B, C, D) R) gs.Either[L, R] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.Left[L, R](v1.Left())
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.Left[L, R](v2.Left())
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.Left[L, R](v3.Left())
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.Left[L, R](v4.Left())
+	}
+	return gs.Right[L](fn(x1, x2, x3, x4))
+}
+
+// Map5 applies fn to the values of v1, v2, v3, v4 and v5, or returns the first Left.
+func Map5[L, A, B, C, D, E, R any](v1 gs.Either[L, A], v2 gs.Either[L, B], v3 gs.Either[L, C], v4 gs.Either[L, D], v5 gs.Either[L, E], fn func(A, B, C, D, E) R) gs.Either[L, R] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.Left[L, R](v1.Left())
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.Left[L, R](v2.Left())
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.Left[L, R](v3.Left())
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.Left[L, R](v4.Left())
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.Left[L, R](v5.Left())
+	}
+	return gs.Right[L](fn(x1, x2, x3, x4, x5))
+}
+
+// Map6 applies fn to the values of v1, v2, v3, v4, v5 and v6, or returns the first Left.
+func Map6[L, A, B, C, D, E, F, R any](v1 gs.Either[L, A], v2 gs.Either[L, B], v3 gs.Either[L, C], v4 gs.Either[L, D], v5 gs.Either[L, E], v6 gs.Either[L, F], fn func(A, B, C, D, E, F) R) gs.Either[L, R] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.Left[L, R](v1.Left())
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.Left[L, R](v2.Left())
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.Left[L, R](v3.Left())
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.Left[L, R](v4.Left())
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.Left[L, R](v5.Left())
+	}
+	x6, ok := v6.Fetch()
+	if !ok {
+		return gs.Left[L, R](v6.Left())
+	}
+	return gs.Right[L](fn(x1, x2, x3, x4, x5, x6))
+}
+
+// Map7 applies fn to the values of v1, v2, v3, v4, v5, v6 and v7, or returns the first Left.
+func Map7[L, A, B, C, D, E, F, G, R any](v1 gs.Either[L, A], v2 gs.Either[L, B], v3 gs.Either[L, C], v4 gs.Either[L, D], v5 gs.Either[L, E], v6 gs.Either[L, F], v7 gs.Either[L, G], fn func(A, B, C, D, E, F, G) R) gs.Either[L, R] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.Left[L, R](v1.Left())
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.Left[L, R](v2.Left())
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.Left[L, R](v3.Left())
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.Left[L, R](v4.Left())
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.Left[L, R](v5.Left())
+	}
+	x6, ok := v6.Fetch()
+	if !ok {
+		return gs.Left[L, R](v6.Left())
+	}
+	x7, ok := v7.Fetch()
+	if !ok {
+		return gs.Left[L, R](v7.Left())
+	}
+	return gs.Right[L](fn(x1, x2, x3, x4, x5, x6, x7))
+}
+
+// Map8 applies fn to the values of v1, v2, v3, v4, v5, v6, v7 and v8, or returns the first Left.
+func Map8[L, A, B, C, D, E, F, G, H, R any](v1 gs.Either[L, A], v2 gs.Either[L, B], v3 gs.Either[L, C], v4 gs.Either[L, D], v5 gs.Either[L, E], v6 gs.Either[L, F], v7 gs.Either[L, G], v8 gs.Either[L, H], fn func(A, B, C, D, E, F, G, H) R) gs.Either[L, R] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.Left[L, R](v1.Left())
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.Left[L, R](v2.Left())
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.Left[L, R](v3.Left())
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.Left[L, R](v4.Left())
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.Left[L, R](v5.Left())
+	}
+	x6, ok := v6.Fetch()
+	if !ok {
+		return gs.Left[L, R](v6.Left())
+	}
+	x7, ok := v7.Fetch()
+	if !ok {
+		return gs.Left[L, R](v7.Left())
+	}
+	x8, ok := v8.Fetch()
+	if !ok {
+		return gs.Left[L, R](v8.Left())
+	}
+	return gs.Right[L](fn(x1, x2, x3, x4, x5, x6, x7, x8))
+}
@@ -0,0 +1,41 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package either
+
+import (
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/iter"
+)
+
+// Traverse applies fn to every element of s and returns Right of all results,
+// or the first Left.
+func Traverse[L, T, R any](s gs.Slice[T], fn func(T) gs.Either[L, R]) gs.Either[L, gs.Slice[R]] {
+	return TraverseIter(iter.Gen(s...), fn)
+}
+
+// TraverseIter is Traverse over an iterator. It stops consuming it at the first Left.
+func TraverseIter[L, T, R any](it iter.Iter[T], fn func(T) gs.Either[L, R]) gs.Either[L, gs.Slice[R]] {
+	ret := make([]R, 0, it.Len())
+	for it.Next() {
+		e := fn(it.Get())
+		v, ok := e.Fetch()
+		if !ok {
+			return gs.Left[L, gs.Slice[R]](e.Left())
+		}
+		ret = append(ret, v)
+	}
+	return gs.Right[L, gs.Slice[R]](ret)
+}
+
+// Sequence turns a slice of Eithers into Right of all values, or the first Left.
+func Sequence[L, R any](s gs.Slice[gs.Either[L, R]]) gs.Either[L, gs.Slice[R]] {
+	return Traverse(s, gs.Id[gs.Either[L, R]])
+}
+
+// SequenceIter is Sequence over an iterator. It stops consuming it at the first Left.
+func SequenceIter[L, R any](it iter.Iter[gs.Either[L, R]]) gs.Either[L, gs.Slice[R]] {
+	return TraverseIter(it, gs.Id[gs.Either[L, R]])
+}
@@ -0,0 +1,40 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package either_test
+
+import (
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/either"
+	"github.com/kigichang/goscala/iter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraverse(t *testing.T) {
+	count := 0
+	positive := func(v int) gs.Either[string, int] {
+		count++
+		return either.Cond(func() bool { return v > 0 }, "not positive", v)
+	}
+
+	e := either.Traverse(gs.Slice[int]{1, 2, 3}, positive)
+	assert.Equal(t, gs.Slice[int]{1, 2, 3}, e.Right())
+	assert.Equal(t, 3, count)
+
+	count = 0
+	e = either.TraverseIter(iter.Gen(1, -2, -3), positive)
+	assert.Equal(t, "not positive", e.Left())
+	assert.Equal(t, 2, count)
+}
+
+func TestSequence(t *testing.T) {
+	e := either.Sequence(gs.Slice[gs.Either[string, int]]{gs.Right[string](1), gs.Right[string](2)})
+	assert.Equal(t, gs.Slice[int]{1, 2}, e.Right())
+
+	e = either.SequenceIter(iter.Gen(gs.Right[string](1), gs.Left[string, int]("a"), gs.Left[string, int]("b")))
+	assert.Equal(t, "a", e.Left())
+}
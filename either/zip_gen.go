@@ -0,0 +1,187 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gsgen -t zip; DO NOT EDIT.
+
+package either
+
+import (
+	gs "github.com/kigichang/goscala"
+)
+
+// Zip tuples the values of v1 and v2, or returns the first Left.
+func Zip[L, A, B any](v1 gs.Either[L, A], v2 gs.Either[L, B]) gs.Either[L, gs.Tuple2[A, B]] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple2[A, B]](v1.Left())
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple2[A, B]](v2.Left())
+	}
+	return gs.Right[L](gs.T2[A, B](x1, x2))
+}
+
+// Zip3 tuples the values of v1, v2 and v3, or returns the first Left.
+func Zip3[L, A, B, C any](v1 gs.Either[L, A], v2 gs.Either[L, B], v3 gs.Either[L, C]) gs.Either[L, gs.Tuple3[A, B, C]] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple3[A, B, C]](v1.Left())
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple3[A, B, C]](v2.Left())
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple3[A, B, C]](v3.Left())
+	}
+	return gs.Right[L](gs.T3[A, B, C](x1, x2, x3))
+}
+
+// Zip4 tuples the values of v1, v2, v3 and v4, or returns the first Left.
+func Zip4[L, A, B, C, D any](v1 gs.Either[L, A], v2 gs.Either[L, B], v3 gs.Either[L, C], v4 gs.Either[L, D]) gs.Either[L, gs.Tuple4[A, B, C, D]] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple4[A, B, C, D]](v1.Left())
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple4[A, B, C, D]](v2.Left())
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple4[A, B, C, D]](v3.Left())
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple4[A, B, C, D]](v4.Left())
+	}
+	return gs.Right[L](gs.T4[A, B, C, D](x1, x2, x3, x4))
+}
+
+// Zip5 tuples the values of v1, v2, v3, v4 and v5, or returns the first Left.
+func Zip5[L, A, B, C, D, E any](v1 gs.Either[L, A], v2 gs.Either[L, B], v3 gs.Either[L, C], v4 gs.Either[L, D], v5 gs.Either[L, E]) gs.Either[L, gs.Tuple5[A, B, C, D, E]] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple5[A, B, C, D, E]](v1.Left())
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple5[A, B, C, D, E]](v2.Left())
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple5[A, B, C, D, E]](v3.Left())
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple5[A, B, C, D, E]](v4.Left())
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple5[A, B, C, D, E]](v5.Left())
+	}
+	return gs.Right[L](gs.T5[A, B, C, D, E](x1, x2, x3, x4, x5))
+}
+
+// Zip6 tuples the values of v1, v2, v3, v4, v5 and v6, or returns the first Left.
+func Zip6[L, A, B, C, D, E, F any](v1 gs.Either[L, A], v2 gs.Either[L, B], v3 gs.Either[L, C], v4 gs.Either[L, D], v5 gs.Either[L, E], v6 gs.Either[L, F]) gs.Either[L, gs.Tuple6[A, B, C, D, E, F]] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple6[A, B, C, D, E, F]](v1.Left())
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple6[A, B, C, D, E, F]](v2.Left())
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple6[A, B, C, D, E, F]](v3.Left())
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple6[A, B, C, D, E, F]](v4.Left())
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple6[A, B, C, D, E, F]](v5.Left())
+	}
+	x6, ok := v6.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple6[A, B, C, D, E, F]](v6.Left())
+	}
+	return gs.Right[L](gs.T6[A, B, C, D, E, F](x1, x2, x3, x4, x5, x6))
+}
+
+// Zip7 tuples the values of v1, v2, v3, v4, v5, v6 and v7, or returns the first Left.
+func Zip7[L, A, B, C, D, E, F, G any](v1 gs.Either[L, A], v2 gs.Either[L, B], v3 gs.Either[L, C], v4 gs.Either[L, D], v5 gs.Either[L, E], v6 gs.Either[L, F], v7 gs.Either[L, G]) gs.Either[L, gs.Tuple7[A, B, C, D, E, F, G]] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple7[A, B, C, D, E, F, G]](v1.Left())
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple7[A, B, C, D, E, F, G]](v2.Left())
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple7[A, B, C, D, E, F, G]](v3.Left())
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple7[A, B, C, D, E, F, G]](v4.Left())
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple7[A, B, C, D, E, F, G]](v5.Left())
+	}
+	x6, ok := v6.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple7[A, B, C, D, E, F, G]](v6.Left())
+	}
+	x7, ok := v7.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple7[A, B, C, D, E, F, G]](v7.Left())
+	}
+	return gs.Right[L](gs.T7[A, B, C, D, E, F, G](x1, x2, x3, x4, x5, x6, x7))
+}
+
+// Zip8 tuples the values of v1, v2, v3, v4, v5, v6, v7 and v8, or returns the first Left.
+func Zip8[L, A, B, C, D, E, F, G, H any](v1 gs.Either[L, A], v2 gs.Either[L, B], v3 gs.Either[L, C], v4 gs.Either[L, D], v5 gs.Either[L, E], v6 gs.Either[L, F], v7 gs.Either[L, G], v8 gs.Either[L, H]) gs.Either[L, gs.Tuple8[A, B, C, D, E, F, G, H]] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple8[A, B, C, D, E, F, G, H]](v1.Left())
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple8[A, B, C, D, E, F, G, H]](v2.Left())
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple8[A, B, C, D, E, F, G, H]](v3.Left())
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple8[A, B, C, D, E, F, G, H]](v4.Left())
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple8[A, B, C, D, E, F, G, H]](v5.Left())
+	}
+	x6, ok := v6.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple8[A, B, C, D, E, F, G, H]](v6.Left())
+	}
+	x7, ok := v7.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple8[A, B, C, D, E, F, G, H]](v7.Left())
+	}
+	x8, ok := v8.Fetch()
+	if !ok {
+		return gs.Left[L, gs.Tuple8[A, B, C, D, E, F, G, H]](v8.Left())
+	}
+	return gs.Right[L](gs.T8[A, B, C, D, E, F, G, H](x1, x2, x3, x4, x5, x6, x7, x8))
+}
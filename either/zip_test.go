@@ -0,0 +1,46 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package either_test
+
+import (
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/either"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip(t *testing.T) {
+	e := either.Zip(gs.Right[string](1), gs.Right[string]("a"))
+	assert.Equal(t, 1, e.Right().V1())
+	assert.Equal(t, "a", e.Right().V2())
+
+	e = either.Zip(gs.Left[string, int]("x"), gs.Left[string, string]("y"))
+	assert.Equal(t, "x", e.Left())
+
+	assert.Equal(t, gs.T3(1, "a", true), either.Zip3(gs.Right[string](1), gs.Right[string]("a"), gs.Right[string](true)).Right())
+	assert.Equal(t, "y", either.Zip3(gs.Right[string](1), gs.Left[string, string]("y"), gs.Left[string, bool]("z")).Left())
+}
+
+func TestMapN(t *testing.T) {
+	add2 := func(a, b int) int { return a + b }
+	add3 := func(a, b, c int) int { return a + b + c }
+	add4 := func(a, b, c, d int) int { return a + b + c + d }
+	add5 := func(a, b, c, d, e int) int { return a + b + c + d + e }
+
+	one := gs.Right[string](1)
+	x := gs.Left[string, int]("x")
+	y := gs.Left[string, int]("y")
+
+	assert.Equal(t, 2, either.Map2(one, one, add2).Right())
+	assert.Equal(t, "x", either.Map2(one, x, add2).Left())
+	assert.Equal(t, 3, either.Map3(one, one, one, add3).Right())
+	assert.Equal(t, "y", either.Map3(y, x, one, add3).Left())
+	assert.Equal(t, 4, either.Map4(one, one, one, one, add4).Right())
+	assert.Equal(t, "x", either.Map4(one, one, x, y, add4).Left())
+	assert.Equal(t, 5, either.Map5(one, one, one, one, one, add5).Right())
+	assert.Equal(t, "y", either.Map5(one, one, one, one, y, add5).Left())
+}
@@ -6,6 +6,8 @@
 package goscala_test
 
 import (
+	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/kigichang/goscala"
@@ -175,3 +177,41 @@ func TestEitherSWap(t *testing.T) {
 	//assert.Equal(t, 2*3, result.Get())
 
 }
+
+func TestEitherJSON(t *testing.T) {
+	data, err := json.Marshal(goscala.Right[string, int](1))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"right":1}`, string(data))
+
+	data, err = json.Marshal(goscala.Left[string, int]("abc"))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"left":"abc"}`, string(data))
+
+	type dto struct {
+		E goscala.Either[string, int] `json:"e"`
+	}
+
+	data, err = json.Marshal(dto{E: goscala.Right[string, int](2)})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"e":{"right":2}}`, string(data))
+}
+
+func TestEitherTry(t *testing.T) {
+	err := errors.New("oops")
+
+	tr := goscala.Right[error, int](1).Try()
+	assert.True(t, tr.IsSuccess())
+	assert.Equal(t, 1, tr.Get())
+
+	tr = goscala.Left[error, int](err).Try()
+	assert.True(t, tr.IsFailure())
+	assert.Equal(t, err, tr.Failed())
+	assert.Equal(t, err, tr.Either().Left())
+
+	tr = goscala.Left[error, int](nil).Try()
+	assert.ErrorIs(t, tr.Failed(), goscala.ErrLeft)
+
+	tr = goscala.Left[string, int]("oops").Try()
+	assert.ErrorIs(t, tr.Failed(), goscala.ErrLeft)
+	assert.Equal(t, &goscala.LeftError{Value: "oops"}, tr.Failed())
+}
@@ -5,7 +5,10 @@
 
 package goscala
 
-import "fmt"
+import (
+	"fmt"
+	"reflect"
+)
 
 var (
 	ErrUnsupported = fmt.Errorf("unsupported")
@@ -13,3 +16,66 @@ var (
 	ErrEmpty       = fmt.Errorf("emtpy")
 	ErrLeft        = fmt.Errorf("left")
 )
+
+// NoSuchElementError reports getting a value from an empty monad. It matches ErrEmpty.
+type NoSuchElementError struct {
+	Monad string
+	Type  string
+}
+
+func (e *NoSuchElementError) Error() string {
+	return fmt.Sprintf(`no such element in %s[%s]`, e.Monad, e.Type)
+}
+
+func (e *NoSuchElementError) Is(target error) bool {
+	return target == ErrEmpty
+}
+
+// UnsatisfiedError reports a value rejected by a predicate. It matches ErrUnsatisfied.
+type UnsatisfiedError struct {
+	Value interface{}
+}
+
+func (e *UnsatisfiedError) Error() string {
+	return fmt.Sprintf(`unsatisfied: %v`, e.Value)
+}
+
+func (e *UnsatisfiedError) Is(target error) bool {
+	return target == ErrUnsatisfied
+}
+
+// LeftError reports a Left where a Right is expected. It matches ErrLeft.
+type LeftError struct {
+	Value interface{}
+}
+
+func (e *LeftError) Error() string {
+	return fmt.Sprintf(`left: %v`, e.Value)
+}
+
+func (e *LeftError) Is(target error) bool {
+	return target == ErrLeft
+}
+
+func NoSuchElement[T any](monad string) error {
+	return &NoSuchElementError{
+		Monad: monad,
+		Type:  reflect.TypeOf((*T)(nil)).Elem().String(),
+	}
+}
+
+func Unsatisfied[T any](v T) error {
+	return &UnsatisfiedError{Value: v}
+}
+
+func LeftErr[L any](v L) error {
+	return &LeftError{Value: v}
+}
+
+// PanicErr returns r, a value of recover, as an error. An error is returned as is.
+func PanicErr(r interface{}) error {
+	if err, ok := r.(error); ok {
+		return err
+	}
+	return fmt.Errorf(`%v`, r)
+}
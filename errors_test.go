@@ -0,0 +1,70 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package goscala_test
+
+import (
+	"errors"
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoSuchElementError(t *testing.T) {
+	_, err := gs.None[int]().FetchErr()
+	assert.ErrorIs(t, err, gs.ErrEmpty)
+	assert.Equal(t, "no such element in Option[int]", err.Error())
+
+	var e *gs.NoSuchElementError
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, "Option", e.Monad)
+	assert.Equal(t, "int", e.Type)
+
+	_, err = gs.None[error]().FetchErr()
+	assert.Equal(t, "no such element in Option[error]", err.Error())
+
+	recovered := func(fn func()) (err error) {
+		defer func() {
+			err, _ = recover().(error)
+		}()
+		fn()
+		return
+	}
+	assert.ErrorIs(t, recovered(func() { gs.None[int]().Get() }), gs.ErrEmpty)
+	assert.ErrorIs(t, recovered(func() { gs.Failure[int](errors.New("oops")).Success() }), gs.ErrEmpty)
+	assert.ErrorIs(t, recovered(func() { gs.Left[string, int]("oops").Right() }), gs.ErrEmpty)
+	assert.Equal(t, "no such element in Either[string]", recovered(func() { gs.Right[string](1).Left() }).Error())
+}
+
+func TestUnsatisfiedError(t *testing.T) {
+	err := gs.Success(-1).Filter(func(v int) bool { return v > 0 }).Failed()
+	assert.ErrorIs(t, err, gs.ErrUnsatisfied)
+	assert.Equal(t, "unsatisfied: -1", err.Error())
+
+	var e *gs.UnsatisfiedError
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, -1, e.Value)
+}
+
+func TestLeftError(t *testing.T) {
+	_, err := gs.Left[string, int]("oops").FetchErr()
+	assert.ErrorIs(t, err, gs.ErrLeft)
+	assert.Equal(t, "left: oops", err.Error())
+
+	var e *gs.LeftError
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, "oops", e.Value)
+
+	_, err = gs.Right[string](1).FetchErr()
+	assert.Nil(t, err)
+}
+
+func TestPanicErr(t *testing.T) {
+	err := errors.New("oops")
+	assert.Equal(t, err, gs.PanicErr(err))
+	assert.EqualError(t, gs.PanicErr("boom"), "boom")
+	assert.EqualError(t, gs.PanicErr(1), "1")
+}
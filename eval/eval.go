@@ -0,0 +1,144 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Package eval controls when a value is evaluated. Map and FlatMap build a
+// chain that Value runs on a trampoline, so deep recursion runs in constant
+// goroutine stack.
+package eval
+
+import (
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/iter"
+)
+
+// Eval is a value that is evaluated Now, Later (once) or Always (every time).
+// The zero value is Now of the zero value of T.
+type Eval[T any] struct {
+	n node
+}
+
+type node interface{}
+
+type pure struct {
+	v interface{}
+}
+
+type thunk struct {
+	fn func() interface{}
+}
+
+type suspend struct {
+	fn func() node
+}
+
+type bind struct {
+	src node
+	fn  func(interface{}) node
+}
+
+// Now is an evaluated value.
+func Now[T any](v T) Eval[T] {
+	return Eval[T]{n: &pure{v: v}}
+}
+
+// Later evaluates fn at the first Value and caches the result.
+func Later[T any](fn func() T) Eval[T] {
+	return FromLazy(gs.Lazy(fn))
+}
+
+// FromLazy is Later backed by z, so the value is shared with other users of z.
+func FromLazy[T any](z *gs.LazyRef[T]) Eval[T] {
+	return Eval[T]{n: &thunk{fn: func() interface{} {
+		return z.Get()
+	}}}
+}
+
+// Always evaluates fn at every Value.
+func Always[T any](fn func() T) Eval[T] {
+	return Eval[T]{n: &thunk{fn: func() interface{} {
+		return fn()
+	}}}
+}
+
+// Defer suspends building an Eval, and is how recursive functions stay stack safe.
+func Defer[T any](fn func() Eval[T]) Eval[T] {
+	return Eval[T]{n: &suspend{fn: func() node {
+		return fn().n
+	}}}
+}
+
+// Value evaluates e on a trampoline.
+func (e Eval[T]) Value() T {
+	var stack []func(interface{}) node
+	cur := e.n
+	for {
+		switch x := cur.(type) {
+		case *pure:
+			if len(stack) == 0 {
+				return cast[T](x.v)
+			}
+			fn := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			cur = fn(x.v)
+		case *thunk:
+			cur = &pure{v: x.fn()}
+		case *suspend:
+			cur = x.fn()
+		case *bind:
+			stack = append(stack, x.fn)
+			cur = x.src
+		default:
+			cur = &pure{}
+		}
+	}
+}
+
+// cast is a type assertion that also accepts nil for interface types.
+func cast[T any](v interface{}) T {
+	t, _ := v.(T)
+	return t
+}
+
+func Map[T, U any](e Eval[T], fn func(T) U) Eval[U] {
+	return Eval[U]{n: &bind{src: e.n, fn: func(v interface{}) node {
+		return &pure{v: fn(cast[T](v))}
+	}}}
+}
+
+func FlatMap[T, U any](e Eval[T], fn func(T) Eval[U]) Eval[U] {
+	return Eval[U]{n: &bind{src: e.n, fn: func(v interface{}) node {
+		return fn(cast[T](v)).n
+	}}}
+}
+
+// FoldRight folds s from the right lazily. fn receives the fold of the rest as
+// an Eval, and short-circuits by not using it.
+func FoldRight[T, U any](s []T, z Eval[U], fn func(T, Eval[U]) Eval[U]) Eval[U] {
+	var loop func(int) Eval[U]
+	loop = func(i int) Eval[U] {
+		return Defer(func() Eval[U] {
+			if i >= len(s) {
+				return z
+			}
+			return fn(s[i], loop(i+1))
+		})
+	}
+	return loop(0)
+}
+
+// FoldRightIter is FoldRight for Iter. Elements are consumed only as they are needed,
+// so the result must be evaluated at most once.
+func FoldRightIter[T, U any](it iter.Iter[T], z Eval[U], fn func(T, Eval[U]) Eval[U]) Eval[U] {
+	var loop func() Eval[U]
+	loop = func() Eval[U] {
+		return Defer(func() Eval[U] {
+			if !it.Next() {
+				return z
+			}
+			return fn(it.Get(), loop())
+		})
+	}
+	return loop()
+}
@@ -0,0 +1,140 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package eval_test
+
+import (
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/eval"
+	"github.com/kigichang/goscala/iter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNowLaterAlways(t *testing.T) {
+	assert.Equal(t, 1, eval.Now(1).Value())
+
+	count := 0
+	later := eval.Later(func() int {
+		count++
+		return count
+	})
+	assert.Equal(t, 0, count)
+	assert.Equal(t, 1, later.Value())
+	assert.Equal(t, 1, later.Value())
+	assert.Equal(t, 1, count)
+
+	count = 0
+	always := eval.Always(func() int {
+		count++
+		return count
+	})
+	assert.Equal(t, 0, count)
+	assert.Equal(t, 1, always.Value())
+	assert.Equal(t, 2, always.Value())
+
+	var err error
+	assert.Nil(t, eval.Map(eval.Now(1), func(int) error { return err }).Value())
+}
+
+func TestMapAndFlatMap(t *testing.T) {
+	a := eval.FlatMap(eval.Now(2), func(v int) eval.Eval[string] {
+		return eval.Map(eval.Later(func() int { return v * 3 }), func(x int) string {
+			return string(rune('a' + x))
+		})
+	})
+	assert.Equal(t, "g", a.Value())
+}
+
+func even(n int) eval.Eval[bool] {
+	return eval.Defer(func() eval.Eval[bool] {
+		if n == 0 {
+			return eval.Now(true)
+		}
+		return odd(n - 1)
+	})
+}
+
+func odd(n int) eval.Eval[bool] {
+	return eval.Defer(func() eval.Eval[bool] {
+		if n == 0 {
+			return eval.Now(false)
+		}
+		return even(n - 1)
+	})
+}
+
+func TestStackSafe(t *testing.T) {
+	assert.True(t, even(1000000).Value())
+	assert.False(t, odd(1000000).Value())
+
+	a := eval.Now(0)
+	for i := 0; i < 1000000; i++ {
+		a = eval.FlatMap(a, func(v int) eval.Eval[int] {
+			return eval.Now(v + 1)
+		})
+	}
+	assert.Equal(t, 1000000, a.Value())
+
+	var sum func(int) eval.Eval[int]
+	sum = func(n int) eval.Eval[int] {
+		if n == 0 {
+			return eval.Now(0)
+		}
+		return eval.Map(eval.Defer(func() eval.Eval[int] { return sum(n - 1) }), func(v int) int {
+			return v + n
+		})
+	}
+	assert.Equal(t, 500000500000, sum(1000000).Value())
+}
+
+func TestFoldRight(t *testing.T) {
+	s := make([]int, 1000000)
+	for i := range s {
+		s[i] = i + 1
+	}
+
+	total := eval.FoldRight(s, eval.Now(0), func(v int, acc eval.Eval[int]) eval.Eval[int] {
+		return eval.Map(acc, func(x int) int { return x + v })
+	})
+	assert.Equal(t, 500000500000, total.Value())
+
+	visited := 0
+	exists := eval.FoldRight(s, eval.Now(false), func(v int, acc eval.Eval[bool]) eval.Eval[bool] {
+		visited++
+		if v == 3 {
+			return eval.Now(true)
+		}
+		return acc
+	})
+	assert.True(t, exists.Value())
+	assert.Equal(t, 3, visited)
+
+	it := iter.Gen(1, 2, 3, 4, 5)
+	exists = eval.FoldRightIter(it, eval.Now(false), func(v int, acc eval.Eval[bool]) eval.Eval[bool] {
+		if v == 2 {
+			return eval.Now(true)
+		}
+		return acc
+	})
+	assert.True(t, exists.Value())
+	assert.True(t, it.Next())
+	assert.Equal(t, 3, it.Get())
+}
+
+func TestFromLazy(t *testing.T) {
+	count := 0
+	z := gs.Lazy(func() int {
+		count++
+		return count
+	})
+
+	e := eval.Map(eval.FromLazy(z), func(v int) int { return v + 1 })
+	assert.Equal(t, 0, count)
+	assert.Equal(t, 2, e.Value())
+	assert.Equal(t, 1, z.Get())
+	assert.Equal(t, 1, count)
+}
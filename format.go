@@ -0,0 +1,92 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package goscala
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	pkgPath = regexp.MustCompile(`(?:[\w.\-]+/)+`)
+	pkgName = regexp.MustCompile(`\bgoscala\.`)
+	comma   = regexp.MustCompile(`,(\S)`)
+)
+
+// TypeName returns the name of type T, without import paths in type arguments.
+// Types of this package are qualified by gs, as the constructors of %#v are.
+// Unlike TypeStr, it works for interface types.
+func TypeName[T any]() string {
+	name := reflect.TypeOf((*T)(nil)).Elem().String()
+	name = pkgPath.ReplaceAllString(name, ``)
+	name = pkgName.ReplaceAllString(name, `gs.`)
+	return comma.ReplaceAllString(name, `, $1`)
+}
+
+// FormatVerb rebuilds the directive, such as %+8.2f, that f and verb come from.
+func FormatVerb(f fmt.State, verb rune) string {
+	var b strings.Builder
+	b.WriteByte('%')
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			b.WriteRune(flag)
+		}
+	}
+	if w, ok := f.Width(); ok {
+		b.WriteString(strconv.Itoa(w))
+	}
+	if p, ok := f.Precision(); ok {
+		b.WriteByte('.')
+		b.WriteString(strconv.Itoa(p))
+	}
+	b.WriteRune(verb)
+	return b.String()
+}
+
+// FormatName writes the name of a value for fmt.Formatter: Name for %v,
+// Name[types] for %+v and pkg.Name[types] for %#v.
+func FormatName(f fmt.State, verb rune, pkg, name, types string) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, `%s.%s[%s]`, pkg, name, types)
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, `%s[%s]`, name, types)
+	default:
+		fmt.Fprint(f, name)
+	}
+}
+
+// FormatApply writes a constructor-like form of a value for fmt.Formatter:
+//
+//	%v    Name(args...)
+//	%+v   Name[types](args...)
+//	%#v   pkg.Name[types](args...)
+//
+// Args are formatted with the same directive, so nested values render consistently.
+func FormatApply(f fmt.State, verb rune, pkg, name, types string, args ...interface{}) {
+	FormatName(f, verb, pkg, name, types)
+	fmt.Fprint(f, `(`)
+	FormatArgs(f, verb, args...)
+	fmt.Fprint(f, `)`)
+}
+
+// FormatArgs writes args separated by commas, each with the directive of f and verb.
+func FormatArgs(f fmt.State, verb rune, args ...interface{}) {
+	directive := FormatVerb(f, verb)
+	for i := range args {
+		if i > 0 {
+			fmt.Fprint(f, `, `)
+		}
+		fmt.Fprintf(f, directive, args[i])
+	}
+}
+
+func isGoSyntax(f fmt.State, verb rune) bool {
+	return verb == 'v' && f.Flag('#')
+}
@@ -0,0 +1,90 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package goscala_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/maps"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatOption(t *testing.T) {
+	assert.Equal(t, "Some(1)", fmt.Sprintf("%v", gs.Some(1)))
+	assert.Equal(t, "Some[int](1)", fmt.Sprintf("%+v", gs.Some(1)))
+	assert.Equal(t, "gs.Some[int](1)", fmt.Sprintf("%#v", gs.Some(1)))
+	assert.Equal(t, `Some("a")`, fmt.Sprintf("%q", gs.Some("a")))
+	assert.Equal(t, "Some(001)", fmt.Sprintf("%03d", gs.Some(1)))
+
+	assert.Equal(t, "None", gs.None[int]().String())
+	assert.Equal(t, "None[int]", fmt.Sprintf("%+v", gs.None[int]()))
+	assert.Equal(t, "gs.None[int]()", gs.None[int]().GoString())
+	assert.Equal(t, "None[error]", fmt.Sprintf("%+v", gs.None[error]()))
+}
+
+func TestFormatEitherAndTry(t *testing.T) {
+	assert.Equal(t, "Right(1)", gs.Right[string](1).String())
+	assert.Equal(t, "Left[string, int](a)", fmt.Sprintf("%+v", gs.Left[string, int]("a")))
+	assert.Equal(t, `gs.Left[string, int]("a")`, fmt.Sprintf("%#v", gs.Left[string, int]("a")))
+
+	assert.Equal(t, "Success(1)", gs.Success(1).String())
+	assert.Equal(t, "Failure(oops)", gs.Failure[int](errors.New("oops")).String())
+	assert.Equal(t, "gs.Success[int](1)", gs.Success(1).GoString())
+
+	assert.Equal(t, "Valid(1)", gs.Valid[string](1).String())
+	assert.Equal(t, `gs.Invalid[string, int]("a", "b")`, gs.Invalid[string, int]("a", "b").GoString())
+}
+
+func TestFormatNested(t *testing.T) {
+	x := gs.Some(gs.Right[string](gs.Success(1)))
+	assert.Equal(t, "Some(Right(Success(1)))", x.String())
+	assert.Equal(t, "Some(Right(Success(1)))", fmt.Sprint(x))
+	assert.Equal(t, "gs.Some[gs.Either[string, gs.Try[int]]](gs.Right[string, gs.Try[int]](gs.Success[int](1)))", fmt.Sprintf("%#v", x))
+	assert.Equal(t, "gs.Slice[gs.Option[int]]{gs.Some[int](1)}", gs.Slice[gs.Option[int]]{gs.Some(1)}.GoString())
+
+	assert.Equal(t, "func(int, string) bool", gs.TypeName[func(int, string) bool]())
+	assert.Equal(t, "struct { A int; B gs.Option[string] }", gs.TypeName[struct {
+		A int
+		B gs.Option[string]
+	}]())
+	assert.Equal(t, "gs.Either[func(int, string), map[string]int]", gs.TypeName[gs.Either[func(int, string), map[string]int]]())
+	assert.Equal(t, "gs.None[func(int, string)]()", fmt.Sprintf("%#v", gs.None[func(int, string)]()))
+
+	s := gs.Slice[gs.Option[int]]{gs.Some(1), gs.None[int]()}
+	assert.Equal(t, "Slice(Some(1), None)", s.String())
+}
+
+func TestFormatSlice(t *testing.T) {
+	s := gs.Slice[int]{1, 2, 3}
+	assert.Equal(t, "Slice(1, 2, 3)", s.String())
+	assert.Equal(t, "Slice[int](1, 2, 3)", fmt.Sprintf("%+v", s))
+	assert.Equal(t, "gs.Slice[int]{1, 2, 3}", s.GoString())
+	assert.Equal(t, "Slice()", gs.Slice[int]{}.String())
+	assert.Equal(t, `["a" "b"]`, fmt.Sprintf("%q", gs.Slice[string]{"a", "b"}))
+	assert.Equal(t, "[001 002 003]", fmt.Sprintf("%03d", s))
+
+	b := gs.Slice[byte]("hi")
+	assert.Equal(t, "hi", fmt.Sprintf("%s", b))
+	assert.Equal(t, "6869", fmt.Sprintf("%x", b))
+	assert.Equal(t, `"hi"`, fmt.Sprintf("%q", b))
+	assert.Equal(t, "Slice(104, 105)", b.String())
+
+	assert.Equal(t, "[1, 2, 3]", s.MkString("[", ", ", "]"))
+	assert.Equal(t, "123", s.MkString("", "", ""))
+	assert.Equal(t, "<>", gs.Slice[int]{}.MkString("<", ",", ">"))
+	assert.Equal(t, "(Some(1))", gs.Slice[gs.Option[int]]{gs.Some(1)}.MkString("(", ",", ")"))
+}
+
+func TestFormatMap(t *testing.T) {
+	m := maps.From(gs.P("b", 2), gs.P("a", 1))
+	assert.Equal(t, "Map(a -> 1, b -> 2)", m.String())
+	assert.Equal(t, "Map[string, int](a -> 1, b -> 2)", fmt.Sprintf("%+v", m))
+	assert.Equal(t, `maps.From(gs.P[string, int]("a", 1), gs.P[string, int]("b", 2))`, m.GoString())
+	assert.Equal(t, "Map()", maps.Empty[string, int]().String())
+}
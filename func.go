@@ -5,6 +5,8 @@
 
 package goscala
 
+//go:generate go run ./cmd/gsgen -t compose -min 3 -max 8 -o compose_gen.go
+
 func FuncCompose[T, A, R any](f func(T) R, g func(A) T) func(A) R {
 	return func(v A) R {
 		return f(g(v))
@@ -3,11 +3,13 @@ package goscala
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 )
 
 type Future[T any] interface {
 	fmt.Stringer
+	slog.LogValuer
 	Completed() bool
 	//Context() context.Context
 	//Value() Try[T]
@@ -8,6 +8,7 @@ package future
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	gs "github.com/kigichang/goscala"
@@ -19,6 +20,7 @@ type _future[T any] struct {
 	cancel    context.CancelFunc
 	completed bool
 	val       gs.Try[T]
+	start     time.Time
 }
 
 var _ gs.Future[int] = &_future[int]{}
@@ -31,6 +33,19 @@ func (f *_future[T]) String() string {
 	return `Future(?)`
 }
 
+// LogValue logs the result like Try, or status=pending if f is not completed.
+// The result is read only after the context of f is done, as Result does.
+func (f *_future[T]) LogValue() slog.Value {
+	select {
+	case <-f.ctx.Done():
+		if v, completed := resulted[T](f.PassValue()); completed {
+			return v.LogValue()
+		}
+	default:
+	}
+	return slog.GroupValue(slog.String(`status`, `pending`))
+}
+
 func (f *_future[T]) Completed() bool {
 	return f.completed
 }
@@ -91,7 +106,7 @@ func (f *_future[T]) Filter(ctx context.Context, p func(T) bool) gs.Future[T] {
 			}
 
 			return Err[T](func() (_ T, err error) {
-				err = gs.ErrUnsatisfied
+				err = gs.Unsatisfied(a.Success())
 				return
 			})
 		}
@@ -104,7 +119,7 @@ func (f *_future[T]) Filter(ctx context.Context, p func(T) bool) gs.Future[T] {
 }
 
 func future[T any]() *_future[T] {
-	f := &_future[T]{}
+	f := &_future[T]{start: time.Now()}
 	f.ctx, f.cancel = context.WithCancel(context.Background())
 	return f
 }
@@ -130,6 +145,11 @@ func Make[T any](fn func() T) gs.Future[T] {
 	return f
 }
 
+// FromLazy forces z in a new goroutine.
+func FromLazy[T any](z *gs.LazyRef[T]) gs.Future[T] {
+	return Make(z.Get)
+}
+
 func Err[T any](fn func() (T, error)) gs.Future[T] {
 	f := future[T]()
 
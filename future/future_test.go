@@ -1,7 +1,11 @@
 package future_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
 	"testing"
 	"time"
 
@@ -65,6 +69,65 @@ func TestFilter(t *testing.T) {
 	h := f.Filter(context.Background(), p2)
 	v, err = h.Result(time.Second)
 	assert.Equal(t, 0, v)
-	assert.Equal(t, gs.ErrUnsatisfied, err)
+	assert.ErrorIs(t, err, gs.ErrUnsatisfied)
+	assert.Equal(t, 5, err.(*gs.UnsatisfiedError).Value)
 
 }
+
+func TestLog(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(buf, nil))
+
+	f := future.Log(context.Background(), future.Err(func() (int, error) {
+		time.Sleep(10 * time.Millisecond)
+		return 1, nil
+	}), logger, "done", "job", "a")
+
+	v, err := f.Result(time.Second)
+	assert.Equal(t, 1, v)
+	assert.Nil(t, err)
+
+	rec := map[string]any{}
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &rec))
+	assert.Equal(t, "INFO", rec["level"])
+	assert.Equal(t, "done", rec["msg"])
+	assert.Equal(t, "a", rec["job"])
+	assert.Equal(t, map[string]any{"status": "success", "value": float64(1)}, rec["result"])
+	assert.GreaterOrEqual(t, rec["duration"], float64(10*time.Millisecond))
+
+	buf.Reset()
+	g := future.Log(context.Background(), future.Err(func() (int, error) {
+		return 0, errors.New("oops")
+	}), logger, "done")
+
+	_, err = g.Result(time.Second)
+	assert.EqualError(t, err, "oops")
+
+	rec = map[string]any{}
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &rec))
+	assert.Equal(t, "ERROR", rec["level"])
+	assert.Equal(t, map[string]any{"status": "failure", "error": "oops"}, rec["result"])
+}
+
+func TestLogValue(t *testing.T) {
+	release := make(chan struct{})
+	f := future.Make(func() int {
+		<-release
+		return 1
+	})
+
+	v := f.(slog.LogValuer).LogValue()
+	assert.Equal(t, slog.GroupValue(slog.String(`status`, `pending`)), v)
+
+	close(release)
+	_, err := f.Result(time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, gs.Success(1).LogValue(), f.(slog.LogValuer).LogValue())
+}
+
+func TestFromLazy(t *testing.T) {
+	z := gs.Lazy(func() int { return 1 })
+	v, err := future.FromLazy(z).Result(time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, v)
+}
@@ -0,0 +1,39 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package future
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	gs "github.com/kigichang/goscala"
+)
+
+// Log logs the outcome of a with its duration when a completes, at Info level
+// on success and Error level on failure. The returned Future completes with
+// the same result after the record is written.
+// The duration is measured from the creation of a, or from the call to Log if
+// a was not created by this package.
+func Log[T any](ctx context.Context, a gs.Future[T], logger *slog.Logger, msg string, attrs ...any) gs.Future[T] {
+	start := time.Now()
+	if f, ok := a.(*_future[T]); ok {
+		start = f.start
+	}
+
+	return Transform(ctx, a, func(v gs.Try[T]) gs.Try[T] {
+		level := slog.LevelInfo
+		if v.IsFailure() {
+			level = slog.LevelError
+		}
+
+		args := make([]any, 0, len(attrs)+2)
+		args = append(args, slog.Any(`result`, v), slog.Duration(`duration`, time.Since(start)))
+		args = append(args, attrs...)
+		logger.Log(ctx, level, msg, args...)
+		return v
+	})
+}
@@ -0,0 +1,215 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Package io describes side effects as values. An IO does nothing until it is
+// run, so it can be composed, retried and raced before anything happens.
+package io
+
+import (
+	"context"
+	"time"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/future"
+	"github.com/kigichang/goscala/try"
+)
+
+// IO is a lazy computation that honors the cancellation of its context.
+type IO[T any] func(context.Context) (T, error)
+
+// Run runs a and returns its result. A panic is returned as Failure.
+func (a IO[T]) Run(ctx context.Context) gs.Try[T] {
+	return try.Err(safe(ctx, a))
+}
+
+// RunAsync runs a in a new goroutine.
+func (a IO[T]) RunAsync(ctx context.Context) gs.Future[T] {
+	return future.Err(func() (T, error) {
+		return safe(ctx, a)
+	})
+}
+
+func safe[T any](ctx context.Context, a IO[T]) (ret T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = gs.PanicErr(r)
+		}
+	}()
+	return a(ctx)
+}
+
+func Pure[T any](v T) IO[T] {
+	return func(context.Context) (T, error) {
+		return v, nil
+	}
+}
+
+func Fail[T any](err error) IO[T] {
+	return func(context.Context) (ret T, _ error) {
+		return ret, err
+	}
+}
+
+// Make suspends fn, which ignores the context.
+func Make[T any](fn func() T) IO[T] {
+	return func(context.Context) (T, error) {
+		return fn(), nil
+	}
+}
+
+// Err suspends fn, which ignores the context.
+func Err[T any](fn func() (T, error)) IO[T] {
+	return func(context.Context) (T, error) {
+		return fn()
+	}
+}
+
+func Map[T, U any](a IO[T], fn func(T) U) IO[U] {
+	return func(ctx context.Context) (ret U, err error) {
+		v, err := a(ctx)
+		if err != nil {
+			return ret, err
+		}
+		return fn(v), nil
+	}
+}
+
+func FlatMap[T, U any](a IO[T], fn func(T) IO[U]) IO[U] {
+	return func(ctx context.Context) (ret U, err error) {
+		v, err := a(ctx)
+		if err != nil {
+			return ret, err
+		}
+		return fn(v)(ctx)
+	}
+}
+
+// Attempt returns an IO that never fails, holding the result of a as Try.
+func Attempt[T any](a IO[T]) IO[gs.Try[T]] {
+	return func(ctx context.Context) (gs.Try[T], error) {
+		return a.Run(ctx), nil
+	}
+}
+
+// Retry runs a at most times times, waiting delay between attempts, until it succeeds.
+// a is always run at least once. It returns the last error, or the error of the context
+// if it is done while waiting.
+func Retry[T any](a IO[T], times int, delay time.Duration) IO[T] {
+	return func(ctx context.Context) (ret T, err error) {
+		for i := 0; i < max(times, 1); i++ {
+			if i > 0 {
+				timer := time.NewTimer(delay)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return ret, ctx.Err()
+				case <-timer.C:
+				}
+			}
+
+			if ret, err = a(ctx); err == nil {
+				return
+			}
+		}
+		return
+	}
+}
+
+// Timeout fails with context.DeadlineExceeded if a does not complete within d.
+// a is cancelled on timeout, but Timeout does not wait for it to return.
+func Timeout[T any](a IO[T], d time.Duration) IO[T] {
+	return func(ctx context.Context) (ret T, err error) {
+		ctx, cancel := context.WithTimeout(ctx, d)
+		defer cancel()
+
+		ch := make(chan gs.Try[T], 1)
+		go func() {
+			ch <- a.Run(ctx)
+		}()
+
+		select {
+		case <-ctx.Done():
+			return ret, ctx.Err()
+		case v := <-ch:
+			return v.FetchErr()
+		}
+	}
+}
+
+// Race runs all IOs concurrently and returns the result of the first to complete,
+// successful or not. The others are cancelled.
+func Race[T any](all ...IO[T]) IO[T] {
+	return func(ctx context.Context) (ret T, err error) {
+		if len(all) == 0 {
+			return ret, gs.NoSuchElement[T](`Race`)
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		ch := make(chan gs.Try[T], len(all))
+		for i := range all {
+			go func(a IO[T]) {
+				ch <- a.Run(ctx)
+			}(all[i])
+		}
+
+		select {
+		case <-ctx.Done():
+			return ret, ctx.Err()
+		case v := <-ch:
+			return v.FetchErr()
+		}
+	}
+}
+
+// Par runs all IOs concurrently as futures and collects their results in order.
+// It fails with the first error and cancels the others.
+func Par[T any](all ...IO[T]) IO[gs.Slice[T]] {
+	type result struct {
+		idx int
+		val gs.Try[T]
+	}
+
+	return func(ctx context.Context) (gs.Slice[T], error) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		ch := make(chan result, len(all))
+		for i := range all {
+			idx := i
+			all[i].RunAsync(ctx).OnComplete(func(v gs.Try[T]) {
+				ch <- result{idx: idx, val: v}
+			})
+		}
+
+		ret := make(gs.Slice[T], len(all))
+		for range all {
+			r := <-ch
+			v, err := r.val.FetchErr()
+			if err != nil {
+				return nil, err
+			}
+			ret[r.idx] = v
+		}
+		return ret, nil
+	}
+}
+
+// Bracket is try.Bracket for IO: it acquires a resource, uses it and always releases it,
+// even if use fails, panics or the context is cancelled. release runs with the values
+// of the context but without its cancellation.
+func Bracket[R, T any](acquire IO[R], use func(R) IO[T], release func(R) IO[gs.UnitRef]) IO[T] {
+	return func(ctx context.Context) (T, error) {
+		return try.Bracket(func() (R, error) {
+			return acquire(ctx)
+		}, func(r R) (T, error) {
+			return use(r)(ctx)
+		}, func(r R) error {
+			_, err := release(r)(context.WithoutCancel(ctx))
+			return err
+		}).FetchErr()
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package io_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/io"
+	"github.com/stretchr/testify/assert"
+)
+
+var errOops = errors.New("oops")
+
+func sleep[T any](d time.Duration, v T) io.IO[T] {
+	return func(ctx context.Context) (ret T, err error) {
+		select {
+		case <-ctx.Done():
+			return ret, ctx.Err()
+		case <-time.After(d):
+			return v, nil
+		}
+	}
+}
+
+func TestLazy(t *testing.T) {
+	count := 0
+	a := io.Make(func() int {
+		count++
+		return count
+	})
+	assert.Equal(t, 0, count)
+
+	b := io.Map(a, func(v int) int { return v * 10 })
+	assert.Equal(t, 0, count)
+
+	assert.Equal(t, 10, b.Run(context.Background()).Get())
+	assert.Equal(t, 20, b.Run(context.Background()).Get())
+}
+
+func TestMapAndFlatMap(t *testing.T) {
+	ctx := context.Background()
+
+	a := io.FlatMap(io.Pure(2), func(v int) io.IO[string] {
+		return io.Map(io.Pure(v*3), strconv.Itoa)
+	})
+	assert.Equal(t, "6", a.Run(ctx).Get())
+
+	called := false
+	b := io.FlatMap(io.Fail[int](errOops), func(v int) io.IO[int] {
+		called = true
+		return io.Pure(v)
+	})
+	assert.ErrorIs(t, b.Run(ctx).Failed(), errOops)
+	assert.False(t, called)
+}
+
+func TestAttemptAndPanic(t *testing.T) {
+	ctx := context.Background()
+
+	a := io.Make(func() int { panic("boom") })
+	assert.EqualError(t, a.Run(ctx).Failed(), "boom")
+
+	v, err := io.Attempt(a)(ctx)
+	assert.Nil(t, err)
+	assert.True(t, v.IsFailure())
+
+	v, err = io.Attempt(io.Pure(1))(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, gs.Success(1).String(), v.String())
+}
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+
+	count := 0
+	a := io.Err(func() (int, error) {
+		count++
+		if count < 3 {
+			return 0, errOops
+		}
+		return count, nil
+	})
+
+	assert.Equal(t, 3, io.Retry(a, 5, time.Millisecond).Run(ctx).Get())
+	assert.Equal(t, 3, count)
+
+	count = 0
+	assert.ErrorIs(t, io.Retry(a, 2, time.Millisecond).Run(ctx).Failed(), errOops)
+	assert.Equal(t, 2, count)
+
+	count = 0
+	assert.ErrorIs(t, io.Retry(a, 0, time.Millisecond).Run(ctx).Failed(), errOops)
+	assert.Equal(t, 1, count)
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	count = 0
+	assert.ErrorIs(t, io.Retry(a, 5, time.Second).Run(cctx).Failed(), context.Canceled)
+	assert.Equal(t, 1, count)
+}
+
+func TestTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Equal(t, 1, io.Timeout(sleep(time.Millisecond, 1), time.Second).Run(ctx).Get())
+	assert.ErrorIs(t, io.Timeout(sleep(time.Second, 1), 10*time.Millisecond).Run(ctx).Failed(), context.DeadlineExceeded)
+}
+
+func TestRace(t *testing.T) {
+	ctx := context.Background()
+
+	a := io.Race(sleep(time.Second, 1), sleep(time.Millisecond, 2), sleep(time.Second, 3))
+	assert.Equal(t, 2, a.Run(ctx).Get())
+
+	assert.ErrorIs(t, io.Race[int]().Run(ctx).Failed(), gs.ErrEmpty)
+}
+
+func TestPar(t *testing.T) {
+	ctx := context.Background()
+
+	start := time.Now()
+	a := io.Par(sleep(30*time.Millisecond, 1), sleep(10*time.Millisecond, 2), sleep(20*time.Millisecond, 3))
+	assert.Equal(t, gs.Slice[int]{1, 2, 3}, a.Run(ctx).Get())
+	assert.Less(t, time.Since(start), 60*time.Millisecond)
+
+	start = time.Now()
+	b := io.Par(sleep(time.Second, 1), io.Fail[int](errOops))
+	assert.ErrorIs(t, b.Run(ctx).Failed(), errOops)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+	f := io.Par(sleep(time.Millisecond, 1)).RunAsync(ctx)
+	v, err := f.Result(time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, gs.Slice[int]{1}, v)
+}
+
+func TestBracket(t *testing.T) {
+	ctx := context.Background()
+
+	var log []string
+	acquire := io.Make(func() string {
+		log = append(log, "acquire")
+		return "r"
+	})
+	release := func(r string) io.IO[gs.UnitRef] {
+		return io.Make(func() gs.UnitRef {
+			log = append(log, "release "+r)
+			return gs.Unit()
+		})
+	}
+
+	a := io.Bracket(acquire, func(r string) io.IO[int] {
+		return io.Make(func() int {
+			log = append(log, "use "+r)
+			return 1
+		})
+	}, release)
+	assert.Equal(t, 1, a.Run(ctx).Get())
+	assert.Equal(t, []string{"acquire", "use r", "release r"}, log)
+
+	log = nil
+	b := io.Bracket(acquire, func(string) io.IO[int] {
+		return io.Make(func() int { panic("boom") })
+	}, release)
+	assert.EqualError(t, b.Run(ctx).Failed(), "boom")
+	assert.Equal(t, []string{"acquire", "release r"}, log)
+
+	c := io.Bracket(acquire, func(string) io.IO[int] {
+		return io.Pure(1)
+	}, func(string) io.IO[gs.UnitRef] {
+		return io.Fail[gs.UnitRef](errOops)
+	})
+	assert.Equal(t, errOops, c.Run(ctx).Failed())
+
+	errUse := errors.New("use")
+	e := io.Bracket(acquire, func(string) io.IO[int] {
+		return io.Fail[int](errUse)
+	}, release)
+	assert.Equal(t, errUse, e.Run(ctx).Failed())
+
+	e = io.Bracket(acquire, func(string) io.IO[int] {
+		return io.Fail[int](errUse)
+	}, func(string) io.IO[gs.UnitRef] {
+		return io.Fail[gs.UnitRef](errOops)
+	})
+	assert.ErrorIs(t, e.Run(ctx).Failed(), errUse)
+	assert.ErrorIs(t, e.Run(ctx).Failed(), errOops)
+
+	log = nil
+	d := io.Bracket(io.Fail[string](errOops), func(string) io.IO[int] {
+		return io.Pure(1)
+	}, release)
+	assert.ErrorIs(t, d.Run(ctx).Failed(), errOops)
+	assert.Nil(t, log)
+}
@@ -5,7 +5,11 @@
 
 package goscala
 
-import "sync"
+import (
+	"math"
+	"sync"
+	"time"
+)
 
 type LazyRef[T any] struct {
 	once sync.Once
@@ -26,6 +30,27 @@ func Lazy[T any](fn func() T) *LazyRef[T] {
 	}
 }
 
+// LazyMap returns a LazyRef of fn applied to z, without forcing z.
+func LazyMap[T, U any](z *LazyRef[T], fn func(T) U) *LazyRef[U] {
+	return Lazy(func() U {
+		return fn(z.Get())
+	})
+}
+
+// LazyFlatMap returns a LazyRef of the value of fn applied to z, without forcing z.
+func LazyFlatMap[T, U any](z *LazyRef[T], fn func(T) *LazyRef[U]) *LazyRef[U] {
+	return Lazy(func() U {
+		return fn(z.Get()).Get()
+	})
+}
+
+// LazyZip returns a Lazy2Ref of the values of a and b, without forcing them.
+func LazyZip[T1, T2 any](a *LazyRef[T1], b *LazyRef[T2]) *Lazy2Ref[T1, T2] {
+	return Lazy2(func() (T1, T2) {
+		return a.Get(), b.Get()
+	})
+}
+
 type Lazy2Ref[T1, T2 any] struct {
 	once sync.Once
 	fn   func() (T1, T2)
@@ -45,3 +70,183 @@ func Lazy2[T1, T2 any](fn func() (T1, T2)) *Lazy2Ref[T1, T2] {
 		fn: fn,
 	}
 }
+
+// LazyTryRef is a lazy value that may fail. Unlike LazyRef, only a success is
+// cached: after a failure or a panic, the next Get runs fn again.
+type LazyTryRef[T any] struct {
+	mu      sync.Mutex
+	fn      func() (T, error)
+	backoff func(int) time.Duration
+	now     func() time.Time
+	val     Try[T]
+	fails   int
+	retryAt time.Time
+}
+
+// Get returns the cached success, or runs fn. A panic of fn is returned as Failure.
+// With a backoff, Get returns the last failure until the backoff elapses.
+func (z *LazyTryRef[T]) Get() Try[T] {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	if z.val != nil && (z.val.IsSuccess() || z.now().Before(z.retryAt)) {
+		return z.val
+	}
+
+	z.val = lazyTry(z.fn)
+	if z.val.IsSuccess() {
+		z.fails = 0
+		return z.val
+	}
+
+	z.fails++
+	if z.backoff != nil {
+		z.retryAt = z.now().Add(z.backoff(z.fails))
+	}
+	return z.val
+}
+
+// IsEvaluated reports whether a success is cached.
+func (z *LazyTryRef[T]) IsEvaluated() bool {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	return z.val != nil && z.val.IsSuccess()
+}
+
+// Reset drops the cached value and failures, so the next Get runs fn again.
+func (z *LazyTryRef[T]) Reset() {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	z.val = nil
+	z.fails = 0
+	z.retryAt = time.Time{}
+}
+
+// WithClock replaces time.Now as the clock of the backoff of z.
+func (z *LazyTryRef[T]) WithClock(now func() time.Time) *LazyTryRef[T] {
+	z.now = now
+	return z
+}
+
+func lazyTry[T any](fn func() (T, error)) (ret Try[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			ret = Failure[T](PanicErr(r))
+		}
+	}()
+
+	v, err := fn()
+	if err != nil {
+		return Failure[T](err)
+	}
+	return Success(v)
+}
+
+func LazyTry[T any](fn func() (T, error)) *LazyTryRef[T] {
+	return &LazyTryRef[T]{
+		fn:  fn,
+		now: time.Now,
+	}
+}
+
+// LazyTryBackoff is LazyTry that waits backoff(n) after the n-th consecutive failure before retrying.
+func LazyTryBackoff[T any](fn func() (T, error), backoff func(int) time.Duration) *LazyTryRef[T] {
+	return &LazyTryRef[T]{
+		fn:      fn,
+		backoff: backoff,
+		now:     time.Now,
+	}
+}
+
+// ExpiringRef is a lazy value that expires after a TTL. Concurrent callers share
+// one computation, and a failure is not cached.
+type ExpiringRef[T any] struct {
+	mu         sync.Mutex
+	fn         func() (T, error)
+	ttl        time.Duration
+	now        func() time.Time
+	async      func(func() (T, error)) Future[T]
+	val        Try[T]
+	expiresAt  time.Time
+	refreshing bool
+}
+
+// Get returns the cached value until it expires, then recomputes it. With
+// WithRevalidate, an expired value is returned while it is recomputed in the background.
+func (z *ExpiringRef[T]) Get() Try[T] {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	if z.val != nil && z.now().Before(z.expiresAt) {
+		return z.val
+	}
+
+	if z.val != nil && z.async != nil {
+		if !z.refreshing {
+			z.refreshing = true
+			go z.revalidate()
+		}
+		return z.val
+	}
+
+	v := lazyTry(z.fn)
+	if v.IsSuccess() {
+		z.set(v)
+	}
+	return v
+}
+
+// revalidate recomputes the value with async out of the lock. refreshing is cleared
+// however the future ends, so a failed or cancelled refresh is retried by the next Get.
+func (z *ExpiringRef[T]) revalidate() {
+	var v Try[T]
+	defer func() {
+		z.mu.Lock()
+		defer z.mu.Unlock()
+
+		z.refreshing = false
+		if v != nil && v.IsSuccess() {
+			z.set(v)
+		}
+	}()
+
+	x, err := z.async(func() (T, error) {
+		return lazyTry(z.fn).FetchErr()
+	}).Result(math.MaxInt64)
+	if err == nil {
+		v = Success(x)
+	}
+}
+
+func (z *ExpiringRef[T]) set(v Try[T]) {
+	z.val = v
+	z.expiresAt = z.now().Add(z.ttl)
+}
+
+// Reset drops the cached value, so the next Get recomputes it.
+func (z *ExpiringRef[T]) Reset() {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	z.val = nil
+}
+
+// WithClock replaces time.Now as the clock of z.
+func (z *ExpiringRef[T]) WithClock(now func() time.Time) *ExpiringRef[T] {
+	z.now = now
+	return z
+}
+
+// WithRevalidate serves the expired value while async, such as future.Err,
+// recomputes it in the background.
+func (z *ExpiringRef[T]) WithRevalidate(async func(func() (T, error)) Future[T]) *ExpiringRef[T] {
+	z.async = async
+	return z
+}
+
+func LazyTTL[T any](fn func() (T, error), ttl time.Duration) *ExpiringRef[T] {
+	return &ExpiringRef[T]{
+		fn:  fn,
+		ttl: ttl,
+		now: time.Now,
+	}
+}
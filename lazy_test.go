@@ -0,0 +1,271 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package goscala_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/future"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazyTry(t *testing.T) {
+	errOops := errors.New("oops")
+	count := 0
+	z := gs.LazyTry(func() (int, error) {
+		count++
+		if count < 3 {
+			return 0, errOops
+		}
+		return count, nil
+	})
+
+	assert.False(t, z.IsEvaluated())
+	assert.ErrorIs(t, z.Get().Failed(), errOops)
+	assert.ErrorIs(t, z.Get().Failed(), errOops)
+	assert.False(t, z.IsEvaluated())
+
+	assert.Equal(t, 3, z.Get().Get())
+	assert.Equal(t, 3, z.Get().Get())
+	assert.True(t, z.IsEvaluated())
+	assert.Equal(t, 3, count)
+
+	z.Reset()
+	assert.False(t, z.IsEvaluated())
+	assert.Equal(t, 4, z.Get().Get())
+}
+
+func TestLazyTryPanic(t *testing.T) {
+	count := 0
+	z := gs.LazyTry(func() (int, error) {
+		count++
+		if count == 1 {
+			panic("boom")
+		}
+		return count, nil
+	})
+
+	assert.EqualError(t, z.Get().Failed(), "boom")
+	assert.False(t, z.IsEvaluated())
+	assert.Equal(t, 2, z.Get().Get())
+}
+
+func TestLazyTryBackoff(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2021, 12, 17, 10, 0, 0, 0, time.UTC)}
+	count := 0
+	z := gs.LazyTryBackoff(func() (int, error) {
+		count++
+		if count <= 2 {
+			return 0, errors.New("oops")
+		}
+		return count, nil
+	}, func(n int) time.Duration {
+		return time.Duration(n) * time.Second
+	}).WithClock(clock.Now)
+
+	assert.True(t, z.Get().IsFailure())
+	clock.Add(999 * time.Millisecond)
+	assert.True(t, z.Get().IsFailure())
+	assert.Equal(t, 1, count)
+
+	clock.Add(time.Millisecond)
+	assert.True(t, z.Get().IsFailure())
+	assert.Equal(t, 2, count)
+
+	clock.Add(time.Second)
+	assert.True(t, z.Get().IsFailure())
+	assert.Equal(t, 2, count)
+
+	clock.Add(time.Second)
+	assert.Equal(t, 3, z.Get().Get())
+}
+
+func TestLazyTryConcurrent(t *testing.T) {
+	count := 0
+	z := gs.LazyTry(func() (int, error) {
+		count++
+		time.Sleep(time.Millisecond)
+		return count, nil
+	})
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, 1, z.Get().Get())
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, count)
+}
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestLazyTTL(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2021, 12, 17, 10, 0, 0, 0, time.UTC)}
+	count := 0
+	z := gs.LazyTTL(func() (int, error) {
+		count++
+		if count == 3 {
+			return 0, errors.New("oops")
+		}
+		return count, nil
+	}, time.Minute).WithClock(clock.Now)
+
+	assert.Equal(t, 1, z.Get().Get())
+	clock.Add(59 * time.Second)
+	assert.Equal(t, 1, z.Get().Get())
+
+	clock.Add(time.Second)
+	assert.Equal(t, 2, z.Get().Get())
+
+	clock.Add(time.Minute)
+	assert.True(t, z.Get().IsFailure())
+	assert.Equal(t, 4, z.Get().Get())
+
+	z.Reset()
+	assert.Equal(t, 5, z.Get().Get())
+}
+
+func TestLazyTTLSingleFlight(t *testing.T) {
+	count := 0
+	z := gs.LazyTTL(func() (int, error) {
+		count++
+		time.Sleep(10 * time.Millisecond)
+		return count, nil
+	}, time.Minute)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, 1, z.Get().Get())
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, count)
+}
+
+func TestLazyTTLRevalidate(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2021, 12, 17, 10, 0, 0, 0, time.UTC)}
+	done := make(chan struct{}, 1)
+	count := 0
+	z := gs.LazyTTL(func() (int, error) {
+		count++
+		if count > 1 {
+			done <- struct{}{}
+		}
+		return count, nil
+	}, time.Minute).WithClock(clock.Now).WithRevalidate(future.Err[int])
+
+	assert.Equal(t, 1, z.Get().Get())
+
+	clock.Add(time.Minute)
+	assert.Equal(t, 1, z.Get().Get())
+	<-done
+
+	assert.Eventually(t, func() bool {
+		return z.Get().Get() == 2
+	}, time.Second, time.Millisecond)
+}
+
+// doneFuture is a completed future that calls OnComplete synchronously.
+type doneFuture[T any] struct {
+	gs.Future[T]
+	v   T
+	err error
+}
+
+func (f doneFuture[T]) Result(time.Duration) (T, error) {
+	return f.v, f.err
+}
+
+func (f doneFuture[T]) OnComplete(fn func(gs.Try[T])) {
+	if f.err != nil {
+		fn(gs.Failure[T](f.err))
+		return
+	}
+	fn(gs.Success(f.v))
+}
+
+func TestLazyTTLRevalidateDone(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2021, 12, 17, 10, 0, 0, 0, time.UTC)}
+	count := 0
+	z := gs.LazyTTL(func() (int, error) {
+		count++
+		return count, nil
+	}, time.Minute).WithClock(clock.Now)
+
+	calls := 0
+	z.WithRevalidate(func(fn func() (int, error)) gs.Future[int] {
+		calls++
+		if calls == 1 {
+			return doneFuture[int]{err: context.Canceled}
+		}
+		v, err := fn()
+		return doneFuture[int]{v: v, err: err}
+	})
+
+	assert.Equal(t, 1, z.Get().Get())
+
+	clock.Add(time.Minute)
+	assert.Equal(t, 1, z.Get().Get())
+
+	// the cancelled refresh does not block the next one
+	assert.Eventually(t, func() bool {
+		return z.Get().Get() == 2
+	}, time.Second, time.Millisecond)
+}
+
+func TestLazyMapAndZip(t *testing.T) {
+	var log []string
+	a := gs.Lazy(func() int {
+		log = append(log, "a")
+		return 2
+	})
+	b := gs.Lazy(func() string {
+		log = append(log, "b")
+		return "x"
+	})
+
+	c := gs.LazyMap(a, func(v int) int { return v * 10 })
+	d := gs.LazyFlatMap(c, func(v int) *gs.LazyRef[string] {
+		return gs.LazyMap(b, func(s string) string { return s + strconv.Itoa(v) })
+	})
+	e := gs.LazyZip(a, d)
+	assert.Nil(t, log)
+
+	v1, v2 := e.Get()
+	assert.Equal(t, 2, v1)
+	assert.Equal(t, "x20", v2)
+	assert.Equal(t, []string{"a", "b"}, log)
+
+	assert.Equal(t, 20, c.Get())
+	assert.Equal(t, []string{"a", "b"}, log)
+}
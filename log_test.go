@@ -0,0 +1,46 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package goscala_test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/stretchr/testify/assert"
+)
+
+func logged(v any) string {
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == slog.MessageKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("", "v", v)
+	return buf.String()
+}
+
+func TestLogValue(t *testing.T) {
+	assert.Equal(t, "v.status=some v.value=1\n", logged(gs.Some(1)))
+	assert.Equal(t, "v.status=none\n", logged(gs.None[int]()))
+
+	assert.Equal(t, "v.status=right v.value=1\n", logged(gs.Right[string](1)))
+	assert.Equal(t, "v.status=left v.value=a\n", logged(gs.Left[string, int]("a")))
+
+	assert.Equal(t, "v.status=success v.value=1\n", logged(gs.Success(1)))
+	assert.Equal(t, "v.status=failure v.error=oops\n", logged(gs.Failure[int](errors.New("oops"))))
+
+	assert.Equal(t, "v.status=valid v.value=1\n", logged(gs.Valid[string](1)))
+	assert.Equal(t, "v.status=invalid v.errors=\"[a b]\"\n", logged(gs.Invalid[string, int]("a", "b")))
+
+	assert.Equal(t, "v.status=some v.value.status=success v.value.value=1\n", logged(gs.Some(gs.Success(1))))
+}
@@ -6,10 +6,16 @@
 package goscala
 
 import (
+	"fmt"
+
 	"github.com/kigichang/goscala/iter/pair"
 )
 
 type Map[K comparable, V any] interface {
+	fmt.Stringer
+	fmt.Formatter
+	fmt.GoStringer
+
 	Len() int
 	Keys() Slice[K]
 	Values() Slice[V]
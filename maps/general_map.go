@@ -1,10 +1,12 @@
 package maps
 
 import (
-	"github.com/kigichang/goscala/iter/pair"
+	"fmt"
+	"reflect"
+	"sort"
 
 	gs "github.com/kigichang/goscala"
-	"reflect"
+	"github.com/kigichang/goscala/iter/pair"
 )
 
 type generalIter[K comparable, V any] struct {
@@ -95,6 +97,49 @@ func (g *generalMap[K, V]) FilterNot(fn func(K, V) bool) gs.Map[K, V] {
 	return formGeneralMap(g.filterNot(fn)...)
 }
 
+func (g *generalMap[K, V]) String() string {
+	return fmt.Sprintf(`%v`, g)
+}
+
+func (g *generalMap[K, V]) GoString() string {
+	return fmt.Sprintf(`%#v`, g)
+}
+
+// Format prints Map(k -> v, ...) for %v, adds the types for %+v and
+// prints a maps.From call for %#v. Entries are sorted by their text.
+func (g *generalMap[K, V]) Format(f fmt.State, verb rune) {
+	types := gs.TypeName[K]() + `, ` + gs.TypeName[V]()
+	directive := gs.FormatVerb(f, verb)
+	goSyntax := verb == 'v' && f.Flag('#')
+
+	layout := directive + ` -> ` + directive
+	if goSyntax {
+		layout = `gs.P[` + types + `](` + directive + `, ` + directive + `)`
+	}
+
+	entries := make([]string, 0, g.Len())
+	it := g.Range()
+	for it.Next() {
+		k, v := it.Get()
+		entries = append(entries, fmt.Sprintf(layout, k, v))
+	}
+	sort.Strings(entries)
+
+	if goSyntax {
+		fmt.Fprint(f, `maps.From(`)
+	} else {
+		gs.FormatName(f, verb, `maps`, `Map`, types)
+		fmt.Fprint(f, `(`)
+	}
+	for i := range entries {
+		if i > 0 {
+			fmt.Fprint(f, `, `)
+		}
+		fmt.Fprint(f, entries[i])
+	}
+	fmt.Fprint(f, `)`)
+}
+
 func newGeneralMap[K comparable, V any](a ...int) *generalMap[K, V] {
 
 	size := 0
@@ -6,7 +6,7 @@
 package maps
 
 import (
-	"constraints"
+	"cmp"
 
 	gs "github.com/kigichang/goscala"
 	"github.com/kigichang/goscala/slices"
@@ -38,6 +38,13 @@ func Collect[K comparable, V, T any](m gs.Map[K, V], pf func(K, V) (T, bool)) gs
 	)
 }
 
+// CollectTupled is Collect with a PartialFunction of key-value tuples.
+func CollectTupled[K comparable, V, T any](m gs.Map[K, V], pf gs.PartialFunction[gs.Tuple2[K, V], T]) gs.Slice[T] {
+	return Collect(m, func(k K, v V) (T, bool) {
+		return pf(gs.T2(k, v))
+	})
+}
+
 func CollectMap[K1, K2 comparable, V1, V2 any](m gs.Map[K1, V1], pf func(K1, V1) (K2, V2, bool)) gs.Map[K2, V2] {
 	ret := Make[K2, V2]()
 
@@ -154,7 +161,7 @@ func MapSlice[K comparable, V, T any](m gs.Map[K, V], fn func(K, V) T) gs.Slice[
 	return ret
 }
 
-func MaxBy[K comparable, V any, B constraints.Ordered](m gs.Map[K, V], fn func(K, V) B) gs.Option[gs.Pair[K, V]] {
+func MaxBy[K comparable, V any, B cmp.Ordered](m gs.Map[K, V], fn func(K, V) B) gs.Option[gs.Pair[K, V]] {
 	fn1 := func(p gs.Pair[K, V]) B {
 		return fn(p.Key(), p.Value())
 	}
@@ -162,7 +169,7 @@ func MaxBy[K comparable, V any, B constraints.Ordered](m gs.Map[K, V], fn func(K
 	return slices.MaxBy(m.Slice(), fn1)
 }
 
-func MinBy[K comparable, V any, B constraints.Ordered](m gs.Map[K, V], fn func(K, V) B) gs.Option[gs.Pair[K, V]] {
+func MinBy[K comparable, V any, B cmp.Ordered](m gs.Map[K, V], fn func(K, V) B) gs.Option[gs.Pair[K, V]] {
 	fn1 := func(p gs.Pair[K, V]) B {
 		return fn(p.Key(), p.Value())
 	}
@@ -0,0 +1,170 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package goscala
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Memo returns a concurrency-safe memoized fn. Concurrent calls with the same
+// argument share one call of fn.
+func Memo[A comparable, B any](fn func(A) B) func(A) B {
+	return MemoWith(fn, 0, 0)
+}
+
+// MemoWith is Memo that keeps at most size results, evicting the least recently
+// used, each for at most ttl. size <= 0 is unbounded and ttl <= 0 never expires.
+// A panic of fn is not cached and passes through to the caller.
+func MemoWith[A comparable, B any](fn func(A) B, size int, ttl time.Duration) func(A) B {
+	return MemoWithClock(fn, size, ttl, time.Now)
+}
+
+// MemoWithClock is MemoWith with now as the clock of ttl instead of time.Now.
+func MemoWithClock[A comparable, B any](fn func(A) B, size int, ttl time.Duration, now func() time.Time) func(A) B {
+	m := newMemo[A, B](size, ttl, now)
+	return func(a A) B {
+		return m.get(a, func() Try[B] {
+			return Success(fn(a))
+		}).Get()
+	}
+}
+
+// MemoTry returns a memoized fn like Memo. Only successes are cached, and a
+// panic of fn is returned as Failure.
+func MemoTry[A comparable, B any](fn func(A) (B, error)) func(A) Try[B] {
+	return MemoTryWith(fn, 0, 0)
+}
+
+// MemoTryWith is MemoTry with the size and ttl of MemoWith.
+func MemoTryWith[A comparable, B any](fn func(A) (B, error), size int, ttl time.Duration) func(A) Try[B] {
+	return MemoTryWithClock(fn, size, ttl, time.Now)
+}
+
+// MemoTryWithClock is MemoTryWith with now as the clock of ttl instead of time.Now.
+func MemoTryWithClock[A comparable, B any](fn func(A) (B, error), size int, ttl time.Duration, now func() time.Time) func(A) Try[B] {
+	m := newMemo[A, B](size, ttl, now)
+	return func(a A) Try[B] {
+		return m.get(a, func() Try[B] {
+			return lazyTry(func() (B, error) {
+				return fn(a)
+			})
+		})
+	}
+}
+
+// MemoFix memoizes a recursive function. fn receives the memoized function
+// itself for its recursive calls, such as:
+//
+//	fib := MemoFix(func(fib func(int) int, n int) int {
+//		if n < 2 {
+//			return n
+//		}
+//		return fib(n-1) + fib(n-2)
+//	})
+func MemoFix[A comparable, B any](fn func(func(A) B, A) B) func(A) B {
+	var self func(A) B
+	self = Memo(func(a A) B {
+		return fn(self, a)
+	})
+	return self
+}
+
+type memoEntry[A comparable, B any] struct {
+	key       A
+	val       Try[B]
+	expiresAt time.Time
+}
+
+// memoCall is a call of fn in flight. val is nil if fn panicked.
+type memoCall[B any] struct {
+	wg  sync.WaitGroup
+	val Try[B]
+}
+
+type memo[A comparable, B any] struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[A]*list.Element
+	order   *list.List
+	calls   map[A]*memoCall[B]
+}
+
+func newMemo[A comparable, B any](size int, ttl time.Duration, now func() time.Time) *memo[A, B] {
+	return &memo[A, B]{
+		size:    size,
+		ttl:     ttl,
+		now:     now,
+		entries: make(map[A]*list.Element),
+		order:   list.New(),
+		calls:   make(map[A]*memoCall[B]),
+	}
+}
+
+func (m *memo[A, B]) get(a A, fn func() Try[B]) Try[B] {
+	for {
+		m.mu.Lock()
+		if elem, ok := m.entries[a]; ok {
+			e := elem.Value.(*memoEntry[A, B])
+			if m.ttl <= 0 || m.now().Before(e.expiresAt) {
+				m.order.MoveToFront(elem)
+				m.mu.Unlock()
+				return e.val
+			}
+			m.order.Remove(elem)
+			delete(m.entries, a)
+		}
+
+		c, ok := m.calls[a]
+		if !ok {
+			c = &memoCall[B]{}
+			c.wg.Add(1)
+			m.calls[a] = c
+			m.mu.Unlock()
+			return m.call(a, c, fn)
+		}
+
+		m.mu.Unlock()
+		c.wg.Wait()
+		if c.val != nil {
+			return c.val
+		}
+		// the call panicked, so try again with this caller
+	}
+}
+
+// call runs fn for the waiters of c. A panic of fn passes through, after c is done.
+func (m *memo[A, B]) call(a A, c *memoCall[B], fn func() Try[B]) Try[B] {
+	defer func() {
+		m.mu.Lock()
+		delete(m.calls, a)
+		if c.val != nil && c.val.IsSuccess() {
+			m.put(a, c.val)
+		}
+		m.mu.Unlock()
+		c.wg.Done()
+	}()
+
+	c.val = fn()
+	return c.val
+}
+
+func (m *memo[A, B]) put(a A, v Try[B]) {
+	m.entries[a] = m.order.PushFront(&memoEntry[A, B]{
+		key:       a,
+		val:       v,
+		expiresAt: m.now().Add(m.ttl),
+	})
+
+	if m.size > 0 && m.order.Len() > m.size {
+		last := m.order.Back()
+		m.order.Remove(last)
+		delete(m.entries, last.Value.(*memoEntry[A, B]).key)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package goscala_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemo(t *testing.T) {
+	calls := map[int]int{}
+	square := gs.Memo(func(a int) int {
+		calls[a]++
+		return a * a
+	})
+
+	assert.Equal(t, 4, square(2))
+	assert.Equal(t, 4, square(2))
+	assert.Equal(t, 9, square(3))
+	assert.Equal(t, map[int]int{2: 1, 3: 1}, calls)
+
+	boom := gs.Memo(func(a int) int { panic(errors.New("boom")) })
+	assert.PanicsWithError(t, "boom", func() { boom(1) })
+
+	count := 0
+	once := gs.Memo(func(a int) int {
+		count++
+		if count == 1 {
+			panic("boom")
+		}
+		return a
+	})
+	assert.PanicsWithValue(t, "boom", func() { once(1) })
+	assert.Equal(t, 1, once(1))
+	assert.Equal(t, 2, count)
+}
+
+func TestMemoSingleFlight(t *testing.T) {
+	var count int32
+	slow := gs.Memo(func(a int) int {
+		atomic.AddInt32(&count, 1)
+		time.Sleep(10 * time.Millisecond)
+		return a
+	})
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, 1, slow(1))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), count)
+}
+
+func TestMemoTry(t *testing.T) {
+	count := 0
+	fn := gs.MemoTry(func(a int) (int, error) {
+		count++
+		if count == 1 {
+			return 0, errors.New("oops")
+		}
+		return a * 10, nil
+	})
+
+	assert.EqualError(t, fn(1).Failed(), "oops")
+	assert.Equal(t, 10, fn(1).Get())
+	assert.Equal(t, 10, fn(1).Get())
+	assert.Equal(t, 2, count)
+}
+
+func TestMemoWith(t *testing.T) {
+	calls := map[int]int{}
+	fn := gs.MemoWith(func(a int) int {
+		calls[a]++
+		return a
+	}, 2, 0)
+
+	fn(1)
+	fn(2)
+	fn(1)
+	fn(3) // evicts 2
+	fn(1)
+	fn(2)
+	assert.Equal(t, map[int]int{1: 1, 2: 2, 3: 1}, calls)
+
+	clock := &fakeClock{now: time.Date(2021, 12, 17, 10, 0, 0, 0, time.UTC)}
+	count := 0
+	ttl := gs.MemoTryWithClock(func(a int) (int, error) {
+		count++
+		return a, nil
+	}, 0, time.Minute, clock.Now)
+
+	ttl(1)
+	clock.Add(59 * time.Second)
+	ttl(1)
+	assert.Equal(t, 1, count)
+	clock.Add(time.Second)
+	ttl(1)
+	assert.Equal(t, 2, count)
+}
+
+func TestMemoFix(t *testing.T) {
+	count := 0
+	fib := gs.MemoFix(func(fib func(int) int, n int) int {
+		count++
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	})
+
+	assert.Equal(t, 12586269025, fib(50))
+	assert.Equal(t, 51, count)
+}
@@ -0,0 +1,49 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package opt_test
+
+import (
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/opt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComprehension(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	lookup := func(k string) gs.Option[int] {
+		v, ok := m[k]
+		return opt.Bool(v, ok)
+	}
+
+	sum := func(x, y string) gs.Option[int] {
+		f := opt.Bind2(opt.Bind1(lookup(x)), func(int) gs.Option[int] { return lookup(y) })
+		return opt.Yield2(f.If(func(a, b int) bool { return a != b }), func(a, b int) int { return a + b })
+	}
+
+	assert.Equal(t, 3, sum("a", "b").Get())
+	assert.True(t, sum("a", "a").IsEmpty())
+	assert.True(t, sum("a", "c").IsEmpty())
+	assert.True(t, sum("c", "a").IsEmpty())
+
+	f4 := opt.Bind4(
+		opt.Bind3(
+			opt.Bind2(opt.Bind1(gs.Some(1)), func(a int) gs.Option[int] { return gs.Some(a + 1) }),
+			func(a, b int) gs.Option[int] { return gs.Some(a + b) },
+		),
+		func(a, b, c int) gs.Option[string] { return gs.None[string]() },
+	)
+	assert.True(t, opt.Yield4(f4, func(a, b, c int, d string) string { return d }).IsEmpty())
+
+	f5 := opt.Bind5(opt.Bind4(opt.Bind3(opt.Bind2(opt.Bind1(gs.Some(1)),
+		func(a int) gs.Option[int] { return gs.Some(2) }),
+		func(a, b int) gs.Option[int] { return gs.Some(3) }),
+		func(a, b, c int) gs.Option[int] { return gs.Some(4) }),
+		func(a, b, c, d int) gs.Option[int] { return gs.Some(5) })
+	assert.Equal(t, 15, opt.Yield5(f5, func(a, b, c, d, e int) int { return a + b + c + d + e }).Get())
+	assert.Equal(t, 1, opt.Yield1(opt.Bind1(gs.Some(1)).If(func(a int) bool { return a > 0 }), gs.Id[int]).Get())
+}
@@ -0,0 +1,46 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package opt
+
+import (
+	gs "github.com/kigichang/goscala"
+)
+
+// Field is an optional struct field for JSON DTOs. It holds at most one
+// element; the zero value is None, encodes as null and is dropped by the
+// omitempty tag option. Use Field instead of gs.Option for DTO fields, since
+// encoding/json can not decode into a field of an interface type such as
+// gs.Option; Option converts a decoded Field.
+type Field[T any] []T
+
+func FieldOf[T any](o gs.Option[T]) Field[T] {
+	return Field[T](o.Slice())
+}
+
+func FieldSome[T any](v T) Field[T] {
+	return Field[T]{v}
+}
+
+func (f Field[T]) Option() gs.Option[T] {
+	return Bool(gs.Slice[T](f).Head())
+}
+
+func (f Field[T]) IsZero() bool {
+	return len(f) == 0
+}
+
+func (f Field[T]) MarshalJSON() ([]byte, error) {
+	return f.Option().MarshalJSON()
+}
+
+func (f *Field[T]) UnmarshalJSON(data []byte) error {
+	o, err := Unmarshal[T](data)
+	if err != nil {
+		return err
+	}
+	*f = FieldOf(o)
+	return nil
+}
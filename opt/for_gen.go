@@ -0,0 +1,206 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gsgen -t for; DO NOT EDIT.
+
+package opt
+
+import (
+	gs "github.com/kigichang/goscala"
+)
+
+// For1 is a for-comprehension after one step.
+type For1[A any] struct {
+	a  A
+	ok bool
+}
+
+// Bind1 starts a for-comprehension over Option. Each following BindN step receives
+// the values of all previous steps, and the chain stops at the first None.
+func Bind1[A any](t gs.Option[A]) For1[A] {
+	a, ok := t.Fetch()
+	return For1[A]{a: a, ok: ok}
+}
+
+// If is a guard. The comprehension becomes None if p does not hold.
+func (s For1[A]) If(p func(A) bool) For1[A] {
+	if s.ok && !p(s.a) {
+		return For1[A]{}
+	}
+	return s
+}
+
+// Yield1 ends the comprehension with the result of fn, or None.
+func Yield1[A, R any](s For1[A], fn func(A) R) gs.Option[R] {
+	if !s.ok {
+		return gs.None[R]()
+	}
+	return gs.Some(fn(s.a))
+}
+
+// For2 is a for-comprehension after two steps.
+type For2[A, B any] struct {
+	a  A
+	b  B
+	ok bool
+}
+
+func Bind2[A, B any](s For1[A], fn func(A) gs.Option[B]) For2[A, B] {
+	if !s.ok {
+		return For2[A, B]{}
+	}
+	v, ok := fn(s.a).Fetch()
+	return For2[A, B]{a: s.a, b: v, ok: ok}
+}
+
+// If is a guard. The comprehension becomes None if p does not hold.
+func (s For2[A, B]) If(p func(A, B) bool) For2[A, B] {
+	if s.ok && !p(s.a, s.b) {
+		return For2[A, B]{}
+	}
+	return s
+}
+
+// Yield2 ends the comprehension with the result of fn, or None.
+func Yield2[A, B, R any](s For2[A, B], fn func(A, B) R) gs.Option[R] {
+	if !s.ok {
+		return gs.None[R]()
+	}
+	return gs.Some(fn(s.a, s.b))
+}
+
+// For3 is a for-comprehension after three steps.
+type For3[A, B, C any] struct {
+	a  A
+	b  B
+	c  C
+	ok bool
+}
+
+func Bind3[A, B, C any](s For2[A, B], fn func(A, B) gs.Option[C]) For3[A, B, C] {
+	if !s.ok {
+		return For3[A, B, C]{}
+	}
+	v, ok := fn(s.a, s.b).Fetch()
+	return For3[A, B, C]{a: s.a, b: s.b, c: v, ok: ok}
+}
+
+// If is a guard. The comprehension becomes None if p does not hold.
+func (s For3[A, B, C]) If(p func(A, B, C) bool) For3[A, B, C] {
+	if s.ok && !p(s.a, s.b, s.c) {
+		return For3[A, B, C]{}
+	}
+	return s
+}
+
+// Yield3 ends the comprehension with the result of fn, or None.
+func Yield3[A, B, C, R any](s For3[A, B, C], fn func(A, B, C) R) gs.Option[R] {
+	if !s.ok {
+		return gs.None[R]()
+	}
+	return gs.Some(fn(s.a, s.b, s.c))
+}
+
+// For4 is a for-comprehension after four steps.
+type For4[A, B, C, D any] struct {
+	a  A
+	b  B
+	c  C
+	d  D
+	ok bool
+}
+
+func Bind4[A, B, C, D any](s For3[A, B, C], fn func(A, B, C) gs.Option[D]) For4[A, B, C, D] {
+	if !s.ok {
+		return For4[A, B, C, D]{}
+	}
+	v, ok := fn(s.a, s.b, s.c).Fetch()
+	return For4[A, B, C, D]{a: s.a, b: s.b, c: s.c, d: v, ok: ok}
+}
+
+// If is a guard. The comprehension becomes None if p does not hold.
+func (s For4[A, B, C, D]) If(p func(A, B, C, D) bool) For4[A, B, C, D] {
+	if s.ok && !p(s.a, s.b, s.c, s.d) {
+		return For4[A, B, C, D]{}
+	}
+	return s
+}
+
+// Yield4 ends the comprehension with the result of fn, or None.
+func Yield4[A, B, C, D, R any](s For4[A, B, C, D], fn func(A, B, C, D) R) gs.Option[R] {
+	if !s.ok {
+		return gs.None[R]()
+	}
+	return gs.Some(fn(s.a, s.b, s.c, s.d))
+}
+
+// For5 is a for-comprehension after five steps.
+type For5[A, B, C, D, E any] struct {
+	a  A
+	b  B
+	c  C
+	d  D
+	e  E
+	ok bool
+}
+
+func Bind5[A, B, C, D, E any](s For4[A, B, C, D], fn func(A, B, C, D) gs.Option[E]) For5[A, B, C, D, E] {
+	if !s.ok {
+		return For5[A, B, C, D, E]{}
+	}
+	v, ok := fn(s.a, s.b, s.c, s.d).Fetch()
+	return For5[A, B, C, D, E]{a: s.a, b: s.b, c: s.c, d: s.d, e: v, ok: ok}
+}
+
+// If is a guard. The comprehension becomes None if p does not hold.
+func (s For5[A, B, C, D, E]) If(p func(A, B, C, D, E) bool) For5[A, B, C, D, E] {
+	if s.ok && !p(s.a, s.b, s.c, s.d, s.e) {
+		return For5[A, B, C, D, E]{}
+	}
+	return s
+}
+
+// Yield5 ends the comprehension with the result of fn, or None.
+func Yield5[A, B, C, D, E, R any](s For5[A, B, C, D, E], fn func(A, B, C, D, E) R) gs.Option[R] {
+	if !s.ok {
+		return gs.None[R]()
+	}
+	return gs.Some(fn(s.a, s.b, s.c, s.d, s.e))
+}
+
+// For6 is a for-comprehension after six steps.
+type For6[A, B, C, D, E, F any] struct {
+	a  A
+	b  B
+	c  C
+	d  D
+	e  E
+	f  F
+	ok bool
+}
+
+func Bind6[A, B, C, D, E, F any](s For5[A, B, C, D, E], fn func(A, B, C, D, E) gs.Option[F]) For6[A, B, C, D, E, F] {
+	if !s.ok {
+		return For6[A, B, C, D, E, F]{}
+	}
+	v, ok := fn(s.a, s.b, s.c, s.d, s.e).Fetch()
+	return For6[A, B, C, D, E, F]{a: s.a, b: s.b, c: s.c, d: s.d, e: s.e, f: v, ok: ok}
+}
+
+// If is a guard. The comprehension becomes None if p does not hold.
+func (s For6[A, B, C, D, E, F]) If(p func(A, B, C, D, E, F) bool) For6[A, B, C, D, E, F] {
+	if s.ok && !p(s.a, s.b, s.c, s.d, s.e, s.f) {
+		return For6[A, B, C, D, E, F]{}
+	}
+	return s
+}
+
+// Yield6 ends the comprehension with the result of fn, or None.
+func Yield6[A, B, C, D, E, F, R any](s For6[A, B, C, D, E, F], fn func(A, B, C, D, E, F) R) gs.Option[R] {
+	if !s.ok {
+		return gs.None[R]()
+	}
+	return gs.Some(fn(s.a, s.b, s.c, s.d, s.e, s.f))
+}
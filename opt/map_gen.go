@@ -0,0 +1,187 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gsgen -t mapn; DO NOT EDIT.
+
+package opt
+
+import (
+	gs "github.com/kigichang/goscala"
+)
+
+// Map2 applies fn to the values of v1 and v2, or returns None if any of them is None.
+func Map2[A, B, R any](v1 gs.Option[A], v2 gs.Option[B], fn func(A, B) R) gs.Option[R] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	return gs.Some(fn(x1, x2))
+}
+
+// Map3 applies fn to the values of v1, v2 and v3, or returns None if any of them is None.
+func Map3[A, B, C, R any](v1 gs.Option[A], v2 gs.Option[B], v3 gs.Option[C], fn func(A, B, C) R) gs.Option[R] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	return gs.Some(fn(x1, x2, x3))
+}
+
+// Map4 applies fn to the values of v1, v2, v3 and v4, or returns None if any of them is None.
+func Map4[A, B, C, D, R any](v1 gs.Option[A], v2 gs.Option[B], v3 gs.Option[C], v4 gs.Option[D], fn func(A, B, C, D) R) gs.Option[R] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	return gs.Some(fn(x1, x2, x3, x4))
+}
+
+// Map5 applies fn to the values of v1, v2, v3, v4 and v5, or returns None if any of them is None.
+func Map5[A, B, C, D, E, R any](v1 gs.Option[A], v2 gs.Option[B], v3 gs.Option[C], v4 gs.Option[D], v5 gs.Option[E], fn func(A, B, C, D, E) R) gs.Option[R] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	return gs.Some(fn(x1, x2, x3, x4, x5))
+}
+
+// Map6 applies fn to the values of v1, v2, v3, v4, v5 and v6, or returns None if any of them is None.
+func Map6[A, B, C, D, E, F, R any](v1 gs.Option[A], v2 gs.Option[B], v3 gs.Option[C], v4 gs.Option[D], v5 gs.Option[E], v6 gs.Option[F], fn func(A, B, C, D, E, F) R) gs.Option[R] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x6, ok := v6.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	return gs.Some(fn(x1, x2, x3, x4, x5, x6))
+}
+
+// Map7 applies fn to the values of v1, v2, v3, v4, v5, v6 and v7, or returns None if any of them is None.
+func Map7[A, B, C, D, E, F, G, R any](v1 gs.Option[A], v2 gs.Option[B], v3 gs.Option[C], v4 gs.Option[D], v5 gs.Option[E], v6 gs.Option[F], v7 gs.Option[G], fn func(A, B, C, D, E, F, G) R) gs.Option[R] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x6, ok := v6.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x7, ok := v7.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	return gs.Some(fn(x1, x2, x3, x4, x5, x6, x7))
+}
+
+// Map8 applies fn to the values of v1, v2, v3, v4, v5, v6, v7 and v8, or returns None if any of them is None.
+func Map8[A, B, C, D, E, F, G, H, R any](v1 gs.Option[A], v2 gs.Option[B], v3 gs.Option[C], v4 gs.Option[D], v5 gs.Option[E], v6 gs.Option[F], v7 gs.Option[G], v8 gs.Option[H], fn func(A, B, C, D, E, F, G, H) R) gs.Option[R] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x6, ok := v6.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x7, ok := v7.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	x8, ok := v8.Fetch()
+	if !ok {
+		return gs.None[R]()
+	}
+	return gs.Some(fn(x1, x2, x3, x4, x5, x6, x7, x8))
+}
@@ -0,0 +1,178 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package opt
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	gs "github.com/kigichang/goscala"
+)
+
+// Null is an optional value for nullable database columns. It implements
+// sql.Scanner and driver.Valuer, mapping NULL to None and back.
+type Null[T any] struct {
+	v     T
+	valid bool
+}
+
+var (
+	_ sql.Scanner   = &Null[int]{}
+	_ driver.Valuer = Null[int]{}
+)
+
+func NullOf[T any](o gs.Option[T]) Null[T] {
+	v, ok := o.Fetch()
+	return Null[T]{v: v, valid: ok}
+}
+
+func NullSome[T any](v T) Null[T] {
+	return Null[T]{v: v, valid: true}
+}
+
+func (n Null[T]) Option() gs.Option[T] {
+	return Bool(n.v, n.valid)
+}
+
+func (n Null[T]) Fetch() (T, bool) {
+	return n.v, n.valid
+}
+
+// Scan implements sql.Scanner. T is scanned by its own Scan if *T is a sql.Scanner;
+// otherwise text is converted to strings, []byte, numbers, bool and time.Time, which
+// accepts RFC 3339 and the "2006-01-02 15:04:05" and "2006-01-02" forms.
+func (n *Null[T]) Scan(src any) error {
+	if src == nil {
+		*n = Null[T]{}
+		return nil
+	}
+
+	var v T
+	if s, ok := any(&v).(sql.Scanner); ok {
+		if err := s.Scan(src); err != nil {
+			return err
+		}
+	} else if err := convertAssign(reflect.ValueOf(&v).Elem(), src); err != nil {
+		return err
+	}
+
+	*n = Null[T]{v: v, valid: true}
+	return nil
+}
+
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.valid {
+		return nil, nil
+	}
+
+	if vr, ok := any(n.v).(driver.Valuer); ok {
+		return vr.Value()
+	}
+	return driver.DefaultParameterConverter.ConvertValue(n.v)
+}
+
+var (
+	timeType  = reflect.TypeOf(time.Time{})
+	bytesType = reflect.TypeOf([]byte(nil))
+)
+
+// timeLayouts are the formats tried, in order, when a text column is scanned into time.Time.
+// Timestamps without a zone are parsed as UTC.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+func convertAssign(dest reflect.Value, src any) error {
+	switch s := src.(type) {
+	case string:
+		return assignString(dest, s)
+	case []byte:
+		if dest.Type() == bytesType {
+			dest.SetBytes(append([]byte(nil), s...))
+			return nil
+		}
+		return assignString(dest, string(s))
+	case time.Time:
+		switch {
+		case dest.Type() == timeType:
+			dest.Set(reflect.ValueOf(s))
+			return nil
+		case dest.Kind() == reflect.String:
+			dest.SetString(s.Format(time.RFC3339Nano))
+			return nil
+		}
+	case int64, float64, bool:
+		if dest.Kind() == reflect.String {
+			dest.SetString(fmt.Sprint(s))
+			return nil
+		}
+		return assignString(dest, fmt.Sprint(s))
+	}
+
+	return fmt.Errorf("opt: unsupported scan, storing %T into %s", src, dest.Type())
+}
+
+func assignString(dest reflect.Value, s string) error {
+	if dest.Type() == bytesType {
+		dest.SetBytes([]byte(s))
+		return nil
+	}
+
+	switch dest.Kind() {
+	case reflect.String:
+		dest.SetString(s)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(s, 10, dest.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("opt: converting %q to %s: %w", s, dest.Type(), err)
+		}
+		dest.SetInt(v)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(s, 10, dest.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("opt: converting %q to %s: %w", s, dest.Type(), err)
+		}
+		dest.SetUint(v)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(s, dest.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("opt: converting %q to %s: %w", s, dest.Type(), err)
+		}
+		dest.SetFloat(v)
+		return nil
+	case reflect.Bool:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("opt: converting %q to %s: %w", s, dest.Type(), err)
+		}
+		dest.SetBool(v)
+		return nil
+	}
+
+	if dest.Type() == timeType {
+		var err error
+		for _, layout := range timeLayouts {
+			var v time.Time
+			if v, err = time.Parse(layout, s); err == nil {
+				dest.Set(reflect.ValueOf(v))
+				return nil
+			}
+		}
+		return fmt.Errorf("opt: converting %q to %s: %w", s, dest.Type(), err)
+	}
+
+	return fmt.Errorf("opt: unsupported scan, storing %q into %s", s, dest.Type())
+}
@@ -0,0 +1,142 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package opt_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+	"time"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/opt"
+	"github.com/stretchr/testify/assert"
+)
+
+// echoDriver returns one row whose columns are the query arguments.
+type echoDriver struct{}
+
+func (echoDriver) Open(string) (driver.Conn, error) { return echoConn{}, nil }
+
+type echoConn struct{}
+
+func (echoConn) Prepare(string) (driver.Stmt, error) { return echoStmt{}, nil }
+func (echoConn) Close() error                        { return nil }
+func (echoConn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+
+type echoStmt struct{}
+
+func (echoStmt) Close() error                               { return nil }
+func (echoStmt) NumInput() int                              { return -1 }
+func (echoStmt) Exec([]driver.Value) (driver.Result, error) { return driver.RowsAffected(0), nil }
+func (echoStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &echoRows{row: args}, nil
+}
+
+type echoRows struct {
+	row  []driver.Value
+	done bool
+}
+
+func (r *echoRows) Columns() []string {
+	return make([]string, len(r.row))
+}
+
+func (r *echoRows) Close() error { return nil }
+
+func (r *echoRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.row)
+	return nil
+}
+
+func init() {
+	sql.Register("opt-echo", echoDriver{})
+}
+
+func TestNull(t *testing.T) {
+	db, err := sql.Open("opt-echo", "")
+	assert.Nil(t, err)
+	defer db.Close()
+
+	now := time.Date(2021, 12, 17, 10, 0, 0, 0, time.UTC)
+
+	var (
+		i  opt.Null[int]
+		s  opt.Null[string]
+		f  opt.Null[float64]
+		b  opt.Null[bool]
+		tm opt.Null[time.Time]
+		bs opt.Null[[]byte]
+		n  opt.Null[int32]
+	)
+
+	err = db.QueryRow("echo",
+		opt.NullSome(1),
+		opt.NullOf(gs.Some("abc")),
+		opt.NullSome(1.5),
+		opt.NullSome(true),
+		opt.NullSome(now),
+		opt.NullSome([]byte("xyz")),
+		opt.NullOf(gs.None[int32]()),
+	).Scan(&i, &s, &f, &b, &tm, &bs, &n)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, i.Option().Get())
+	assert.Equal(t, "abc", s.Option().Get())
+	assert.Equal(t, 1.5, f.Option().Get())
+	assert.Equal(t, true, b.Option().Get())
+	assert.Equal(t, now, tm.Option().Get())
+	assert.Equal(t, []byte("xyz"), bs.Option().Get())
+	assert.True(t, n.Option().IsEmpty())
+}
+
+func TestNullScan(t *testing.T) {
+	var i opt.Null[int8]
+	assert.Nil(t, i.Scan([]byte("12")))
+	assert.Equal(t, int8(12), i.Option().Get())
+
+	assert.NotNil(t, i.Scan(int64(1000)))
+	assert.NotNil(t, i.Scan("abc"))
+
+	assert.Nil(t, i.Scan(nil))
+	assert.True(t, i.Option().IsEmpty())
+
+	var s opt.Null[string]
+	assert.Nil(t, s.Scan(int64(10)))
+	assert.Equal(t, "10", s.Option().Get())
+
+	var ns opt.Null[sql.NullString]
+	assert.Nil(t, ns.Scan("abc"))
+	assert.Equal(t, sql.NullString{String: "abc", Valid: true}, ns.Option().Get())
+
+	var b opt.Null[[]byte]
+	assert.Nil(t, b.Scan("abc"))
+	assert.Equal(t, []byte("abc"), b.Option().Get())
+
+	var tm opt.Null[time.Time]
+	assert.Nil(t, tm.Scan("2006-01-02 15:04:05"))
+	assert.Equal(t, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), tm.Option().Get())
+	assert.Nil(t, tm.Scan([]byte("2006-01-02 15:04:05.123")))
+	assert.Equal(t, time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC), tm.Option().Get())
+	assert.Nil(t, tm.Scan("2006-01-02"))
+	assert.Equal(t, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), tm.Option().Get())
+	assert.Nil(t, tm.Scan("2006-01-02T15:04:05Z"))
+	assert.Equal(t, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), tm.Option().Get())
+	assert.NotNil(t, tm.Scan("02/01/2006"))
+
+	v, err := opt.NullSome(int16(3)).Value()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), v)
+
+	v, err = opt.NullOf(gs.None[int]()).Value()
+	assert.Nil(t, err)
+	assert.Nil(t, v)
+}
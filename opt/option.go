@@ -5,7 +5,13 @@
 
 package opt
 
+//go:generate go run ../cmd/gsgen -t mapn -monad option -max 8 -o map_gen.go
+//go:generate go run ../cmd/gsgen -t zip -monad option -max 8 -o zip_gen.go
+//go:generate go run ../cmd/gsgen -t for -monad option -max 6 -o for_gen.go
+
 import (
+	"encoding/json"
+
 	gs "github.com/kigichang/goscala"
 )
 
@@ -78,3 +84,20 @@ func Right[L, T any](opt gs.Option[T], z L) gs.Either[L, T] {
 		gs.FuncUnitAndThen(gs.VF(z), gs.Left[L, T]),
 	)(opt.Fetch)
 }
+
+// Unmarshal decodes JSON null as None and any other value as Some.
+func Unmarshal[T any](data []byte) (gs.Option[T], error) {
+	o := gs.None[T]()
+	if err := json.Unmarshal(data, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// Try returns Success of the value, or Failure of err if opt is None.
+func Try[T any](opt gs.Option[T], err error) gs.Try[T] {
+	return gs.Partial(
+		gs.Success[T],
+		gs.FuncUnitAndThen(gs.VF(err), gs.Failure[T]),
+	)(opt.Fetch)
+}
@@ -6,6 +6,7 @@
 package opt_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"testing"
@@ -156,3 +157,48 @@ func TestUnless(t *testing.T) {
 	assert.True(t, o.IsDefined())
 	assert.Equal(t, 0, o.Get())
 }
+
+func TestField(t *testing.T) {
+	type dto struct {
+		Name opt.Field[string] `json:"name"`
+		Age  opt.Field[int]    `json:"age,omitempty"`
+	}
+
+	data, err := json.Marshal(dto{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name":null}`, string(data))
+
+	data, err = json.Marshal(dto{
+		Name: opt.FieldOf(gs.Some("abc")),
+		Age:  opt.FieldSome(10),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name":"abc","age":10}`, string(data))
+
+	var x dto
+	err = json.Unmarshal([]byte(`{"name":"abc"}`), &x)
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", x.Name.Option().Get())
+	assert.True(t, x.Age.Option().IsEmpty())
+	assert.True(t, x.Age.IsZero())
+
+	x = dto{Name: opt.FieldSome("abc")}
+	err = json.Unmarshal([]byte(`{"name":null,"age":10}`), &x)
+	assert.Nil(t, err)
+	assert.True(t, x.Name.Option().IsEmpty())
+	assert.Equal(t, 10, x.Age.Option().Get())
+}
+
+func TestTry(t *testing.T) {
+	err := fmt.Errorf("oops")
+
+	tr := opt.Try(gs.Some(1), err)
+	assert.Equal(t, 1, tr.Get())
+
+	tr = opt.Try(gs.None[int](), err)
+	assert.Equal(t, err, tr.Failed())
+
+	// Option -> Try -> Option keeps the value.
+	assert.Equal(t, 1, opt.Try(gs.Some(1), err).Option().Get())
+	assert.True(t, opt.Try(gs.None[int](), err).Option().IsEmpty())
+}
@@ -0,0 +1,40 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package opt
+
+import (
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/iter"
+)
+
+// Traverse applies fn to every element of s and returns Some of all results,
+// or None if any result is None.
+func Traverse[T, U any](s gs.Slice[T], fn func(T) gs.Option[U]) gs.Option[gs.Slice[U]] {
+	return TraverseIter(iter.Gen(s...), fn)
+}
+
+// TraverseIter is Traverse over an iterator. It stops consuming it at the first None.
+func TraverseIter[T, U any](it iter.Iter[T], fn func(T) gs.Option[U]) gs.Option[gs.Slice[U]] {
+	ret := make([]U, 0, it.Len())
+	for it.Next() {
+		v, ok := fn(it.Get()).Fetch()
+		if !ok {
+			return gs.None[gs.Slice[U]]()
+		}
+		ret = append(ret, v)
+	}
+	return gs.Some[gs.Slice[U]](ret)
+}
+
+// Sequence turns a slice of Options into Some of all values, or None if any is None.
+func Sequence[T any](s gs.Slice[gs.Option[T]]) gs.Option[gs.Slice[T]] {
+	return Traverse(s, gs.Id[gs.Option[T]])
+}
+
+// SequenceIter is Sequence over an iterator. It stops consuming it at the first None.
+func SequenceIter[T any](it iter.Iter[gs.Option[T]]) gs.Option[gs.Slice[T]] {
+	return TraverseIter(it, gs.Id[gs.Option[T]])
+}
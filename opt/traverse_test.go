@@ -0,0 +1,43 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package opt_test
+
+import (
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/iter"
+	"github.com/kigichang/goscala/opt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraverse(t *testing.T) {
+	count := 0
+	positive := func(v int) gs.Option[int] {
+		count++
+		return opt.Bool(v, v > 0)
+	}
+
+	o := opt.Traverse(gs.Slice[int]{1, 2, 3}, positive)
+	assert.Equal(t, gs.Slice[int]{1, 2, 3}, o.Get())
+	assert.Equal(t, 3, count)
+
+	count = 0
+	o = opt.TraverseIter(iter.Gen(1, -2, 3), positive)
+	assert.True(t, o.IsEmpty())
+	assert.Equal(t, 2, count)
+
+	o = opt.Traverse(gs.Slice[int]{}, positive)
+	assert.Equal(t, 0, o.Get().Len())
+}
+
+func TestSequence(t *testing.T) {
+	o := opt.Sequence(gs.Slice[gs.Option[int]]{gs.Some(1), gs.Some(2)})
+	assert.Equal(t, gs.Slice[int]{1, 2}, o.Get())
+
+	o = opt.SequenceIter(iter.Gen(gs.Some(1), gs.None[int]()))
+	assert.True(t, o.IsEmpty())
+}
@@ -0,0 +1,187 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gsgen -t zip; DO NOT EDIT.
+
+package opt
+
+import (
+	gs "github.com/kigichang/goscala"
+)
+
+// Zip tuples the values of v1 and v2, or returns None if any of them is None.
+func Zip[A, B any](v1 gs.Option[A], v2 gs.Option[B]) gs.Option[gs.Tuple2[A, B]] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple2[A, B]]()
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple2[A, B]]()
+	}
+	return gs.Some(gs.T2[A, B](x1, x2))
+}
+
+// Zip3 tuples the values of v1, v2 and v3, or returns None if any of them is None.
+func Zip3[A, B, C any](v1 gs.Option[A], v2 gs.Option[B], v3 gs.Option[C]) gs.Option[gs.Tuple3[A, B, C]] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple3[A, B, C]]()
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple3[A, B, C]]()
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple3[A, B, C]]()
+	}
+	return gs.Some(gs.T3[A, B, C](x1, x2, x3))
+}
+
+// Zip4 tuples the values of v1, v2, v3 and v4, or returns None if any of them is None.
+func Zip4[A, B, C, D any](v1 gs.Option[A], v2 gs.Option[B], v3 gs.Option[C], v4 gs.Option[D]) gs.Option[gs.Tuple4[A, B, C, D]] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple4[A, B, C, D]]()
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple4[A, B, C, D]]()
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple4[A, B, C, D]]()
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple4[A, B, C, D]]()
+	}
+	return gs.Some(gs.T4[A, B, C, D](x1, x2, x3, x4))
+}
+
+// Zip5 tuples the values of v1, v2, v3, v4 and v5, or returns None if any of them is None.
+func Zip5[A, B, C, D, E any](v1 gs.Option[A], v2 gs.Option[B], v3 gs.Option[C], v4 gs.Option[D], v5 gs.Option[E]) gs.Option[gs.Tuple5[A, B, C, D, E]] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple5[A, B, C, D, E]]()
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple5[A, B, C, D, E]]()
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple5[A, B, C, D, E]]()
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple5[A, B, C, D, E]]()
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple5[A, B, C, D, E]]()
+	}
+	return gs.Some(gs.T5[A, B, C, D, E](x1, x2, x3, x4, x5))
+}
+
+// Zip6 tuples the values of v1, v2, v3, v4, v5 and v6, or returns None if any of them is None.
+func Zip6[A, B, C, D, E, F any](v1 gs.Option[A], v2 gs.Option[B], v3 gs.Option[C], v4 gs.Option[D], v5 gs.Option[E], v6 gs.Option[F]) gs.Option[gs.Tuple6[A, B, C, D, E, F]] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple6[A, B, C, D, E, F]]()
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple6[A, B, C, D, E, F]]()
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple6[A, B, C, D, E, F]]()
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple6[A, B, C, D, E, F]]()
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple6[A, B, C, D, E, F]]()
+	}
+	x6, ok := v6.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple6[A, B, C, D, E, F]]()
+	}
+	return gs.Some(gs.T6[A, B, C, D, E, F](x1, x2, x3, x4, x5, x6))
+}
+
+// Zip7 tuples the values of v1, v2, v3, v4, v5, v6 and v7, or returns None if any of them is None.
+func Zip7[A, B, C, D, E, F, G any](v1 gs.Option[A], v2 gs.Option[B], v3 gs.Option[C], v4 gs.Option[D], v5 gs.Option[E], v6 gs.Option[F], v7 gs.Option[G]) gs.Option[gs.Tuple7[A, B, C, D, E, F, G]] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple7[A, B, C, D, E, F, G]]()
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple7[A, B, C, D, E, F, G]]()
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple7[A, B, C, D, E, F, G]]()
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple7[A, B, C, D, E, F, G]]()
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple7[A, B, C, D, E, F, G]]()
+	}
+	x6, ok := v6.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple7[A, B, C, D, E, F, G]]()
+	}
+	x7, ok := v7.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple7[A, B, C, D, E, F, G]]()
+	}
+	return gs.Some(gs.T7[A, B, C, D, E, F, G](x1, x2, x3, x4, x5, x6, x7))
+}
+
+// Zip8 tuples the values of v1, v2, v3, v4, v5, v6, v7 and v8, or returns None if any of them is None.
+func Zip8[A, B, C, D, E, F, G, H any](v1 gs.Option[A], v2 gs.Option[B], v3 gs.Option[C], v4 gs.Option[D], v5 gs.Option[E], v6 gs.Option[F], v7 gs.Option[G], v8 gs.Option[H]) gs.Option[gs.Tuple8[A, B, C, D, E, F, G, H]] {
+	x1, ok := v1.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple8[A, B, C, D, E, F, G, H]]()
+	}
+	x2, ok := v2.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple8[A, B, C, D, E, F, G, H]]()
+	}
+	x3, ok := v3.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple8[A, B, C, D, E, F, G, H]]()
+	}
+	x4, ok := v4.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple8[A, B, C, D, E, F, G, H]]()
+	}
+	x5, ok := v5.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple8[A, B, C, D, E, F, G, H]]()
+	}
+	x6, ok := v6.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple8[A, B, C, D, E, F, G, H]]()
+	}
+	x7, ok := v7.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple8[A, B, C, D, E, F, G, H]]()
+	}
+	x8, ok := v8.Fetch()
+	if !ok {
+		return gs.None[gs.Tuple8[A, B, C, D, E, F, G, H]]()
+	}
+	return gs.Some(gs.T8[A, B, C, D, E, F, G, H](x1, x2, x3, x4, x5, x6, x7, x8))
+}
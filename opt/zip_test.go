@@ -0,0 +1,46 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package opt_test
+
+import (
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/opt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip(t *testing.T) {
+	o := opt.Zip(gs.Some(1), gs.Some("a"))
+	assert.Equal(t, 1, o.Get().V1())
+	assert.Equal(t, "a", o.Get().V2())
+
+	assert.True(t, opt.Zip(gs.None[int](), gs.Some("a")).IsEmpty())
+	assert.True(t, opt.Zip(gs.Some(1), gs.None[string]()).IsEmpty())
+
+	assert.Equal(t, gs.T3(1, "a", true), opt.Zip3(gs.Some(1), gs.Some("a"), gs.Some(true)).Get())
+	assert.True(t, opt.Zip3(gs.Some(1), gs.None[string](), gs.Some(true)).IsEmpty())
+	assert.Equal(t, gs.T8(1, 2, 3, 4, 5, 6, 7, 8), opt.Zip8(gs.Some(1), gs.Some(2), gs.Some(3), gs.Some(4), gs.Some(5), gs.Some(6), gs.Some(7), gs.Some(8)).Get())
+}
+
+func TestMapN(t *testing.T) {
+	add2 := func(a, b int) int { return a + b }
+	add3 := func(a, b, c int) int { return a + b + c }
+	add4 := func(a, b, c, d int) int { return a + b + c + d }
+	add5 := func(a, b, c, d, e int) int { return a + b + c + d + e }
+
+	one := gs.Some(1)
+	none := gs.None[int]()
+
+	assert.Equal(t, 2, opt.Map2(one, one, add2).Get())
+	assert.True(t, opt.Map2(one, none, add2).IsEmpty())
+	assert.Equal(t, 3, opt.Map3(one, one, one, add3).Get())
+	assert.True(t, opt.Map3(none, one, one, add3).IsEmpty())
+	assert.Equal(t, 4, opt.Map4(one, one, one, one, add4).Get())
+	assert.True(t, opt.Map4(one, one, one, none, add4).IsEmpty())
+	assert.Equal(t, 5, opt.Map5(one, one, one, one, one, add5).Get())
+	assert.True(t, opt.Map5(one, one, none, one, one, add5).IsEmpty())
+}
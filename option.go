@@ -6,11 +6,20 @@
 package goscala
 
 import (
+	"encoding/json"
 	"fmt"
+	"log/slog"
 )
 
+// Option is an optional value. It encodes to JSON as its value or null, but a
+// struct field of type Option can not be decoded since Option is an interface.
+// Use opt.Field for optional fields of DTOs, or opt.Unmarshal to decode an Option.
 type Option[T any] interface {
 	fmt.Stringer
+	fmt.Formatter
+	fmt.GoStringer
+	slog.LogValuer
+	json.Marshaler
 	Fetcher[T]
 	IsDefined() bool
 	IsEmpty() bool
@@ -36,11 +45,32 @@ type option[T any] struct {
 var _ Option[int] = &option[int]{}
 
 func (opt *option[T]) String() string {
-	if opt.defined {
-		return fmt.Sprintf(`Some(%v)`, opt.v)
+	return fmt.Sprintf(`%v`, opt)
+}
+
+func (opt *option[T]) GoString() string {
+	return fmt.Sprintf(`%#v`, opt)
+}
+
+// Format prints Some(v) or None for %v, adds the type for %+v and
+// prints Go syntax for %#v.
+func (opt *option[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case opt.defined:
+		FormatApply(f, verb, `gs`, `Some`, TypeName[T](), opt.v)
+	case isGoSyntax(f, verb):
+		FormatApply(f, verb, `gs`, `None`, TypeName[T]())
+	default:
+		FormatName(f, verb, `gs`, `None`, TypeName[T]())
 	}
+}
 
-	return fmt.Sprintf(`None[%s]`, TypeStr(opt.v))
+// LogValue logs status=some with the value, or status=none.
+func (opt *option[T]) LogValue() slog.Value {
+	if opt.defined {
+		return slog.GroupValue(slog.String(`status`, `some`), slog.Any(`value`, opt.v))
+	}
+	return slog.GroupValue(slog.String(`status`, `none`))
 }
 
 func (opt *option[T]) Fetch() (T, bool) {
@@ -48,7 +78,10 @@ func (opt *option[T]) Fetch() (T, bool) {
 }
 
 func (opt *option[T]) FetchErr() (T, error) {
-	return opt.v, Cond(opt.defined, nil, ErrEmpty)
+	if opt.defined {
+		return opt.v, nil
+	}
+	return opt.v, NoSuchElement[T](`Option`)
 }
 
 func (opt *option[T]) IsDefined() bool {
@@ -63,7 +96,7 @@ func (opt *option[T]) Get() T {
 	if opt.defined {
 		return opt.v
 	}
-	panic(fmt.Sprintf(`can not get value from %v`, opt))
+	panic(NoSuchElement[T](`Option`))
 }
 
 func (opt *option[T]) GetOrElse(z T) T {
@@ -129,6 +162,29 @@ func (opt *option[T]) Slice() Slice[T] {
 	)(opt.Fetch)
 }
 
+// MarshalJSON encodes Some as its value and None as null.
+func (opt *option[T]) MarshalJSON() ([]byte, error) {
+	if opt.defined {
+		return json.Marshal(opt.v)
+	}
+	return []byte(`null`), nil
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some.
+func (opt *option[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == `null` {
+		*opt = option[T]{}
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*opt = option[T]{defined: true, v: v}
+	return nil
+}
+
 func Some[T any](v T) Option[T] {
 	return &option[T]{
 		defined: true,
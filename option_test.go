@@ -6,6 +6,7 @@
 package goscala_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -234,3 +235,24 @@ func TestOptionOrElse(t *testing.T) {
 	assert.Equal(t, true, n.OrElse(f).IsDefined())
 	assert.Equal(t, 1, n.OrElse(f).Get())
 }
+
+func TestOptionJSON(t *testing.T) {
+	data, err := json.Marshal(gs.Some(100))
+	assert.Nil(t, err)
+	assert.Equal(t, `100`, string(data))
+
+	data, err = json.Marshal(gs.None[int]())
+	assert.Nil(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	o, err := opt.Unmarshal[int]([]byte(`100`))
+	assert.Nil(t, err)
+	assert.Equal(t, 100, o.Get())
+
+	o, err = opt.Unmarshal[int]([]byte(`null`))
+	assert.Nil(t, err)
+	assert.True(t, o.IsEmpty())
+
+	_, err = opt.Unmarshal[int]([]byte(`"abc"`))
+	assert.NotNil(t, err)
+}
@@ -40,3 +40,65 @@ func GetOrElse[T any](v T, ok bool) func(T) T {
 		return Cond(ok, v, z)
 	}
 }
+
+// PartialFunction is a function defined only for some inputs. It can be passed
+// wherever a func(T) (U, bool) is expected, such as slices.Collect and Try.Recover.
+type PartialFunction[T, U any] func(T) (U, bool)
+
+// Case returns a PartialFunction that applies fn to the values satisfying p.
+func Case[T, U any](p func(T) bool, fn func(T) U) PartialFunction[T, U] {
+	return func(v T) (ret U, ok bool) {
+		if ok = p(v); ok {
+			ret = fn(v)
+		}
+		return
+	}
+}
+
+// Cases returns a PartialFunction that applies the first case defined at a value.
+func Cases[T, U any](cases ...PartialFunction[T, U]) PartialFunction[T, U] {
+	return func(v T) (ret U, ok bool) {
+		for _, pf := range cases {
+			if ret, ok = pf(v); ok {
+				return
+			}
+		}
+		return
+	}
+}
+
+func (pf PartialFunction[T, U]) IsDefinedAt(v T) bool {
+	_, ok := pf(v)
+	return ok
+}
+
+// Apply applies pf to v. It panics if pf is not defined at v.
+func (pf PartialFunction[T, U]) Apply(v T) U {
+	if ret, ok := pf(v); ok {
+		return ret
+	}
+	panic(Unsatisfied(v))
+}
+
+// Lift turns pf into a total function returning Option.
+func (pf PartialFunction[T, U]) Lift() func(T) Option[U] {
+	return func(v T) Option[U] {
+		return PartialV(Some[U], None[U])(pf(v))
+	}
+}
+
+// OrElse returns a PartialFunction that applies pf, or that where pf is not defined.
+func (pf PartialFunction[T, U]) OrElse(that PartialFunction[T, U]) PartialFunction[T, U] {
+	return Cases(pf, that)
+}
+
+// PartialFuncAndThen applies fn to the results of pf.
+func PartialFuncAndThen[T, U, R any](pf PartialFunction[T, U], fn func(U) R) PartialFunction[T, R] {
+	return func(v T) (ret R, ok bool) {
+		u, ok := pf(v)
+		if ok {
+			ret = fn(u)
+		}
+		return
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package goscala_test
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/maps"
+	"github.com/kigichang/goscala/opt"
+	"github.com/kigichang/goscala/slices"
+	"github.com/kigichang/goscala/try"
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(v int) bool { return v%2 == 0 }
+
+func TestPartialFunction(t *testing.T) {
+	even := gs.Case(isEven, strconv.Itoa)
+	assert.True(t, even.IsDefinedAt(2))
+	assert.False(t, even.IsDefinedAt(1))
+	assert.Equal(t, "2", even.Apply(2))
+	assert.Panics(t, func() { even.Apply(1) })
+
+	assert.Equal(t, gs.Some("2").String(), even.Lift()(2).String())
+	assert.True(t, even.Lift()(1).IsEmpty())
+
+	negative := gs.Case(func(v int) bool { return v < 0 }, func(int) string { return "negative" })
+	pf := negative.OrElse(even)
+	assert.Equal(t, "negative", pf.Apply(-2))
+	assert.Equal(t, "4", pf.Apply(4))
+	assert.False(t, pf.IsDefinedAt(3))
+
+	cases := gs.Cases(negative, even, gs.Case(func(int) bool { return true }, func(int) string { return "odd" }))
+	assert.Equal(t, "odd", cases.Apply(3))
+
+	length := gs.PartialFuncAndThen(even, func(s string) int { return len(s) })
+	assert.Equal(t, 2, length.Apply(10))
+	assert.False(t, length.IsDefinedAt(11))
+}
+
+func TestPartialFunctionAccepted(t *testing.T) {
+	even := gs.Case(isEven, strconv.Itoa)
+
+	assert.Equal(t, gs.Slice[string]{"2", "4"}, slices.Collect(slices.From(1, 2, 3, 4), even))
+	assert.Equal(t, "2", opt.Collect(gs.Some(2), even).Get())
+	assert.True(t, opt.Collect(gs.Some(1), even).IsEmpty())
+	assert.Equal(t, "2", try.Collect(gs.Success(2), even).Get())
+
+	eof := gs.Case(func(err error) bool { return errors.Is(err, io.EOF) }, func(error) int { return 0 })
+	assert.Equal(t, 0, gs.Failure[int](io.EOF).Recover(eof).Get())
+	assert.True(t, gs.Failure[int](errors.New("oops")).Recover(eof).IsFailure())
+
+	m := maps.From(gs.P("a", 1), gs.P("b", 2))
+	values := maps.CollectTupled(m, gs.Case(
+		func(t gs.Tuple2[string, int]) bool { return isEven(t.V2()) },
+		func(t gs.Tuple2[string, int]) string { return t.V1() },
+	))
+	assert.Equal(t, gs.Slice[string]{"b"}, values)
+}
@@ -5,6 +5,10 @@
 
 package goscala
 
+import (
+	"cmp"
+)
+
 func Predict[T, U any](succ func(T) U, fail func() U) func(func(T) bool) func(T) U {
 	return func(p func(T) bool) func(T) U {
 		return func(v T) U {
@@ -35,3 +39,123 @@ func PredictTransform[T, U, R any](succ func(U) R, fail func(T) R) func(func(T)
 //		return
 //	}
 //}
+
+// And returns a predicate satisfied when both p and q are.
+func And[T any](p, q func(T) bool) func(T) bool {
+	return func(v T) bool {
+		return p(v) && q(v)
+	}
+}
+
+// Or returns a predicate satisfied when p or q is.
+func Or[T any](p, q func(T) bool) func(T) bool {
+	return func(v T) bool {
+		return p(v) || q(v)
+	}
+}
+
+func Not[T any](p func(T) bool) func(T) bool {
+	return func(v T) bool {
+		return !p(v)
+	}
+}
+
+// Xor returns a predicate satisfied when exactly one of p and q is.
+func Xor[T any](p, q func(T) bool) func(T) bool {
+	return func(v T) bool {
+		return p(v) != q(v)
+	}
+}
+
+// AllOf returns a predicate satisfied when all of ps are. It is true for no predicates.
+func AllOf[T any](ps ...func(T) bool) func(T) bool {
+	return func(v T) bool {
+		for _, p := range ps {
+			if !p(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// AnyOf returns a predicate satisfied when any of ps is. It is false for no predicates.
+func AnyOf[T any](ps ...func(T) bool) func(T) bool {
+	return func(v T) bool {
+		for _, p := range ps {
+			if p(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NoneOf returns a predicate satisfied when none of ps is.
+func NoneOf[T any](ps ...func(T) bool) func(T) bool {
+	return Not(AnyOf(ps...))
+}
+
+// Is returns a predicate satisfied by values equal to x.
+func Is[T comparable](x T) func(T) bool {
+	return func(v T) bool {
+		return v == x
+	}
+}
+
+// Gt returns a predicate satisfied by values greater than x.
+func Gt[T cmp.Ordered](x T) func(T) bool {
+	return func(v T) bool {
+		return v > x
+	}
+}
+
+// Ge returns a predicate satisfied by values greater than or equal to x.
+func Ge[T cmp.Ordered](x T) func(T) bool {
+	return func(v T) bool {
+		return v >= x
+	}
+}
+
+// Lt returns a predicate satisfied by values less than x.
+func Lt[T cmp.Ordered](x T) func(T) bool {
+	return func(v T) bool {
+		return v < x
+	}
+}
+
+// Le returns a predicate satisfied by values less than or equal to x.
+func Le[T cmp.Ordered](x T) func(T) bool {
+	return func(v T) bool {
+		return v <= x
+	}
+}
+
+// Between returns a predicate satisfied by values in [lo, hi].
+func Between[T cmp.Ordered](lo, hi T) func(T) bool {
+	return func(v T) bool {
+		return lo <= v && v <= hi
+	}
+}
+
+// In returns a predicate satisfied by any of xs. A Slice can be passed as xs...
+func In[T comparable](xs ...T) func(T) bool {
+	set := make(map[T]struct{}, len(xs))
+	for _, x := range xs {
+		set[x] = struct{}{}
+	}
+	return func(v T) bool {
+		_, ok := set[v]
+		return ok
+	}
+}
+
+// InKeys returns a predicate satisfied by the keys of m.
+func InKeys[K comparable, V any](m Map[K, V]) func(K) bool {
+	return m.Contains
+}
+
+// By returns a predicate satisfied when the key of a value satisfies p.
+func By[T, K any](key func(T) K, p func(K) bool) func(T) bool {
+	return FuncAndThen(key, p)
+}
@@ -0,0 +1,58 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package goscala_test
+
+import (
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/maps"
+	"github.com/kigichang/goscala/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPredicateCombinators(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	positive := gs.Gt(0)
+
+	assert.True(t, gs.And(even, positive)(2))
+	assert.False(t, gs.And(even, positive)(-2))
+	assert.True(t, gs.Or(even, positive)(-2))
+	assert.False(t, gs.Or(even, positive)(-1))
+	assert.True(t, gs.Not(even)(1))
+	assert.True(t, gs.Xor(even, positive)(1))
+	assert.False(t, gs.Xor(even, positive)(2))
+
+	assert.True(t, gs.AllOf(even, positive, gs.Lt(10))(4))
+	assert.False(t, gs.AllOf(even, positive, gs.Lt(10))(12))
+	assert.True(t, gs.AllOf[int]()(1))
+	assert.True(t, gs.AnyOf(even, gs.Is(3))(3))
+	assert.False(t, gs.AnyOf[int]()(1))
+	assert.True(t, gs.NoneOf(even, positive)(-1))
+
+	s := slices.From(1, 2, 3, 4, 5, 6)
+	assert.Equal(t, gs.Slice[int]{2, 4}, s.Filter(gs.And(even, gs.Between(2, 4))))
+	assert.Equal(t, gs.Slice[int]{1, 2}, s.Filter(gs.Le(2)))
+	assert.Equal(t, gs.Slice[int]{5, 6}, s.Filter(gs.Ge(5)))
+	assert.True(t, s.Exists(gs.In(slices.From(0, 6, 7)...)))
+	assert.False(t, s.Exists(gs.In[int]()))
+}
+
+func TestPredicateBy(t *testing.T) {
+	type user struct {
+		name string
+		age  int
+	}
+	users := gs.Slice[user]{{"alice", 30}, {"bob", 17}, {"carol", 65}}
+
+	adult := gs.By(func(u user) int { return u.age }, gs.Ge(18))
+	assert.Equal(t, gs.Slice[user]{{"alice", 30}, {"carol", 65}}, users.Filter(adult))
+	assert.False(t, users.Forall(adult))
+
+	admins := maps.From(gs.P("alice", true))
+	isAdmin := gs.By(func(u user) string { return u.name }, gs.InKeys[string, bool](admins))
+	assert.Equal(t, gs.Slice[user]{{"alice", 30}}, users.Filter(isAdmin))
+}
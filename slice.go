@@ -5,7 +5,11 @@
 
 package goscala
 
-import "sort"
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
 
 type Slice[T any] []T
 
@@ -370,6 +374,47 @@ func (s Slice[T]) Sort(compare func(T, T) int) Slice[T] {
 	return s
 }
 
+func (s Slice[T]) any() []interface{} {
+	ret := make([]interface{}, len(s))
+	for i := range s {
+		ret[i] = s[i]
+	}
+	return ret
+}
+
+// MkString joins the elements between start and end, separated by sep.
+func (s Slice[T]) MkString(start, sep, end string) string {
+	a := make([]string, len(s))
+	for i := range s {
+		a[i] = fmt.Sprint(s[i])
+	}
+	return start + strings.Join(a, sep) + end
+}
+
+func (s Slice[T]) String() string {
+	return fmt.Sprintf(`%v`, s)
+}
+
+func (s Slice[T]) GoString() string {
+	return fmt.Sprintf(`%#v`, s)
+}
+
+// Format prints Slice(a, b, c) for %v, adds the type for %+v and
+// prints a Go composite literal for %#v. Other verbs format s as []T,
+// so %s of Slice[byte] is the text.
+func (s Slice[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb != 'v':
+		fmt.Fprintf(f, FormatVerb(f, verb), []T(s))
+	case isGoSyntax(f, verb):
+		fmt.Fprintf(f, `gs.Slice[%s]{`, TypeName[T]())
+		FormatArgs(f, verb, s.any()...)
+		fmt.Fprint(f, `}`)
+	default:
+		FormatApply(f, verb, `gs`, `Slice`, TypeName[T](), s.any()...)
+	}
+}
+
 //func (s Slice[T]) Iter() Iter[T] {
 //	return newIter(&s)
 //}
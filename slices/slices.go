@@ -6,7 +6,7 @@
 package slices
 
 import (
-	"constraints"
+	"cmp"
 	"sort"
 
 	gs "github.com/kigichang/goscala"
@@ -170,7 +170,7 @@ func GroupMapReduce[T any, K comparable, V any](s gs.Slice[T], key func(T) K, va
 	return ret
 }
 
-func maxBy[T any, B constraints.Ordered](s gs.Slice[T], fn func(T) B, cmp func(B, B) int) gs.Option[T] {
+func maxBy[T any, B cmp.Ordered](s gs.Slice[T], fn func(T) B, compare func(B, B) int) gs.Option[T] {
 	size := s.Len()
 	if size == 0 {
 		return gs.None[T]()
@@ -184,7 +184,7 @@ func maxBy[T any, B constraints.Ordered](s gs.Slice[T], fn func(T) B, cmp func(B
 	x := fn(s[0])
 	for i := 1; i < size; i++ {
 		y := fn(s[i])
-		if cmp(x, y) < 0 {
+		if compare(x, y) < 0 {
 			x = y
 			v = s[i]
 		}
@@ -193,18 +193,18 @@ func maxBy[T any, B constraints.Ordered](s gs.Slice[T], fn func(T) B, cmp func(B
 	return gs.Some[T](v)
 }
 
-func MaxBy[T any, B constraints.Ordered](s gs.Slice[T], fn func(T) B) gs.Option[T] {
+func MaxBy[T any, B cmp.Ordered](s gs.Slice[T], fn func(T) B) gs.Option[T] {
 	return maxBy(s, fn, gs.Compare[B])
 }
 
-func MinBy[T any, B constraints.Ordered](s gs.Slice[T], fn func(T) B) gs.Option[T] {
-	cmp := func(v1, v2 B) int {
+func MinBy[T any, B cmp.Ordered](s gs.Slice[T], fn func(T) B) gs.Option[T] {
+	compare := func(v1, v2 B) int {
 		return -gs.Compare(v1, v2)
 	}
-	return maxBy(s, fn, cmp)
+	return maxBy(s, fn, compare)
 }
 
-func SortBy[T any, B constraints.Ordered](s gs.Slice[T], fn func(T) B) gs.Slice[T] {
+func SortBy[T any, B cmp.Ordered](s gs.Slice[T], fn func(T) B) gs.Slice[T] {
 	sort.SliceStable(s, func(i, j int) bool {
 		return fn(s[i]) < fn(s[j])
 	})
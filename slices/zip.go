@@ -0,0 +1,70 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package slices
+
+import (
+	gs "github.com/kigichang/goscala"
+)
+
+// Zip pairs up the elements of a and b. The result is as long as the shorter one.
+func Zip[A, B any](a gs.Slice[A], b gs.Slice[B]) gs.Slice[gs.Tuple2[A, B]] {
+	ret := make(gs.Slice[gs.Tuple2[A, B]], min(len(a), len(b)))
+	for i := range ret {
+		ret[i] = gs.T2(a[i], b[i])
+	}
+	return ret
+}
+
+// Zip3 is Zip of three slices.
+func Zip3[A, B, C any](a gs.Slice[A], b gs.Slice[B], c gs.Slice[C]) gs.Slice[gs.Tuple3[A, B, C]] {
+	ret := make(gs.Slice[gs.Tuple3[A, B, C]], min(len(a), len(b), len(c)))
+	for i := range ret {
+		ret[i] = gs.T3(a[i], b[i], c[i])
+	}
+	return ret
+}
+
+// ZipAll pairs up the elements of a and b. The result is as long as the longer one,
+// and the shorter one is filled with za or zb.
+func ZipAll[A, B any](a gs.Slice[A], b gs.Slice[B], za A, zb B) gs.Slice[gs.Tuple2[A, B]] {
+	ret := make(gs.Slice[gs.Tuple2[A, B]], max(len(a), len(b)))
+	for i := range ret {
+		x, y := za, zb
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		ret[i] = gs.T2(x, y)
+	}
+	return ret
+}
+
+// ZipWithIndex pairs up each element with its index.
+func ZipWithIndex[T any](s gs.Slice[T]) gs.Slice[gs.Tuple2[T, int]] {
+	ret := make(gs.Slice[gs.Tuple2[T, int]], len(s))
+	for i := range s {
+		ret[i] = gs.T2(s[i], i)
+	}
+	return ret
+}
+
+func Unzip[A, B any](s gs.Slice[gs.Tuple2[A, B]]) (gs.Slice[A], gs.Slice[B]) {
+	a, b := make(gs.Slice[A], len(s)), make(gs.Slice[B], len(s))
+	for i := range s {
+		a[i], b[i] = s[i].Get()
+	}
+	return a, b
+}
+
+func Unzip3[A, B, C any](s gs.Slice[gs.Tuple3[A, B, C]]) (gs.Slice[A], gs.Slice[B], gs.Slice[C]) {
+	a, b, c := make(gs.Slice[A], len(s)), make(gs.Slice[B], len(s)), make(gs.Slice[C], len(s))
+	for i := range s {
+		a[i], b[i], c[i] = s[i].Get()
+	}
+	return a, b, c
+}
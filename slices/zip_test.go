@@ -0,0 +1,37 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package slices_test
+
+import (
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/slices"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip(t *testing.T) {
+	a := slices.From(1, 2, 3)
+	b := slices.From("a", "b")
+	c := slices.From(true, false, true)
+
+	assert.Equal(t, gs.Slice[gs.Tuple2[int, string]]{gs.T2(1, "a"), gs.T2(2, "b")}, slices.Zip(a, b))
+	assert.Equal(t, gs.Slice[gs.Tuple3[int, string, bool]]{gs.T3(1, "a", true), gs.T3(2, "b", false)}, slices.Zip3(a, b, c))
+	assert.Equal(t, gs.Slice[gs.Tuple2[int, string]]{gs.T2(1, "a"), gs.T2(2, "b"), gs.T2(3, "z")}, slices.ZipAll(a, b, 0, "z"))
+	assert.Equal(t, gs.Slice[gs.Tuple2[string, int]]{gs.T2("a", 0), gs.T2("b", 1)}, slices.ZipWithIndex(b))
+	assert.Empty(t, slices.Zip(a, slices.Empty[string]()))
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := slices.Unzip(slices.Zip(slices.From(1, 2), slices.From("a", "b")))
+	assert.Equal(t, gs.Slice[int]{1, 2}, a)
+	assert.Equal(t, gs.Slice[string]{"a", "b"}, b)
+
+	x, y, z := slices.Unzip3(slices.Zip3(slices.From(1), slices.From("a"), slices.From(true)))
+	assert.Equal(t, gs.Slice[int]{1}, x)
+	assert.Equal(t, gs.Slice[string]{"a"}, y)
+	assert.Equal(t, gs.Slice[bool]{true}, z)
+}
@@ -6,12 +6,18 @@
 package goscala
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 )
 
 type Try[T any] interface {
 	fmt.Stringer
+	fmt.Formatter
+	fmt.GoStringer
+	slog.LogValuer
+	json.Marshaler
 	Fetcher[T]
 	IsSuccess() bool
 	Success() T
@@ -27,7 +33,7 @@ type Try[T any] interface {
 	RecoverWith(func(error) (Try[T], bool)) Try[T]
 
 	Option() Option[T]
-	//Either() Either[error, T]
+	Either() Either[error, T]
 	Slice() Slice[T]
 }
 
@@ -38,18 +44,37 @@ type try[T any] struct {
 
 var _ Try[int] = &try[int]{}
 
-//func (t *try[T]) either() *either[error, T] {
-//	if t.err == nil {
-//		return right[error, T](t.v)
-//	}
-//	return left[error, T](t.err)
-//}
+func (t *try[T]) either() *either[error, T] {
+	if t.err == nil {
+		return right[error, T](t.v)
+	}
+	return left[error, T](t.err)
+}
 
 func (t *try[T]) String() string {
+	return fmt.Sprintf(`%v`, t)
+}
+
+func (t *try[T]) GoString() string {
+	return fmt.Sprintf(`%#v`, t)
+}
+
+// Format prints Success(v) or Failure(err) for %v, adds the type for %+v and
+// prints Go syntax for %#v.
+func (t *try[T]) Format(f fmt.State, verb rune) {
 	if t.IsSuccess() {
-		return fmt.Sprintf(`Success(%v)`, t.v)
+		FormatApply(f, verb, `gs`, `Success`, TypeName[T](), t.v)
+		return
 	}
-	return fmt.Sprintf(`Failure(%v)`, t.err)
+	FormatApply(f, verb, `gs`, `Failure`, TypeName[T](), t.err)
+}
+
+// LogValue logs status=success with the value, or status=failure with the error.
+func (t *try[T]) LogValue() slog.Value {
+	if t.IsSuccess() {
+		return slog.GroupValue(slog.String(`status`, `success`), slog.Any(`value`, t.v))
+	}
+	return slog.GroupValue(slog.String(`status`, `failure`), slog.String(`error`, t.err.Error()))
 }
 
 func (t *try[T]) IsSuccess() bool {
@@ -60,7 +85,7 @@ func (t *try[T]) Success() T {
 	if t.IsSuccess() {
 		return t.v
 	}
-	panic(fmt.Errorf(`can not get success value from %v`, t))
+	panic(NoSuchElement[T](`Try`))
 }
 
 func (t *try[T]) IsFailure() bool {
@@ -101,10 +126,12 @@ func (t *try[T]) Get() T {
 
 func (t *try[T]) Filter(p func(T) bool) Try[T] {
 	return PartialErr(
-		Predict(
-			Success[T],
-			VF(Failure[T](ErrUnsatisfied)),
-		)(p),
+		func(v T) Try[T] {
+			if p(v) {
+				return Success(v)
+			}
+			return Failure[T](Unsatisfied(v))
+		},
 		Failure[T],
 	)(t.FetchErr)
 }
@@ -145,9 +172,9 @@ func (t *try[T]) Option() Option[T] {
 	)(t.Fetch)
 }
 
-//func (t *try[T]) Either() Either[error, T] {
-//	return t.either()
-//}
+func (t *try[T]) Either() Either[error, T] {
+	return t.either()
+}
 
 func (t *try[T]) Slice() Slice[T] {
 	return Partial(
@@ -156,6 +183,42 @@ func (t *try[T]) Slice() Slice[T] {
 	)(t.Fetch)
 }
 
+type tryJSON struct {
+	Success json.RawMessage `json:"success,omitempty"`
+	Failure *string         `json:"failure,omitempty"`
+}
+
+// MarshalJSON encodes Success as {"success": v} and Failure as {"failure": "message"}.
+func (t *try[T]) MarshalJSON() ([]byte, error) {
+	if t.IsSuccess() {
+		return json.Marshal(map[string]T{"success": t.v})
+	}
+	return json.Marshal(map[string]string{"failure": t.err.Error()})
+}
+
+// UnmarshalJSON decodes an object holding exactly one of "success" or "failure".
+// The failure message is restored as a plain error.
+func (t *try[T]) UnmarshalJSON(data []byte) error {
+	var x tryJSON
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+
+	switch {
+	case x.Success != nil && x.Failure == nil:
+		var v T
+		if err := json.Unmarshal(x.Success, &v); err != nil {
+			return err
+		}
+		*t = try[T]{v: v}
+	case x.Failure != nil && x.Success == nil:
+		*t = try[T]{err: errors.New(*x.Failure)}
+	default:
+		return fmt.Errorf(`try: expect exactly one of "success" or "failure" in %s`, data)
+	}
+	return nil
+}
+
 func success[T any](v T) *try[T] {
 	return &try[T]{
 		v:   v,
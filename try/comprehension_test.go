@@ -0,0 +1,73 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package try_test
+
+import (
+	"strconv"
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/try"
+	"github.com/stretchr/testify/assert"
+)
+
+func atoi(s string) gs.Try[int] {
+	return try.Err(strconv.Atoi(s))
+}
+
+func TestComprehension(t *testing.T) {
+	/*
+		for {
+			a <- Try("1".toInt)
+			b <- Try("2".toInt)
+			if a < b
+			c <- Try((a + b).toString)
+		} yield s"$a + $b = $c"
+	*/
+	calc := func(x, y string) gs.Try[string] {
+		f2 := try.Bind2(try.Bind1(atoi(x)), func(int) gs.Try[int] { return atoi(y) })
+		f3 := try.Bind3(f2.If(func(a, b int) bool { return a < b }), func(a, b int) gs.Try[string] {
+			return gs.Success(strconv.Itoa(a + b))
+		})
+		return try.Yield3(f3, func(a, b int, c string) string {
+			return strconv.Itoa(a) + " + " + strconv.Itoa(b) + " = " + c
+		})
+	}
+
+	assert.Equal(t, "1 + 2 = 3", calc("1", "2").Get())
+	assert.ErrorIs(t, calc("2", "1").Failed(), gs.ErrUnsatisfied)
+	assert.Equal(t, gs.Unsatisfied(gs.T2(2, 1)), calc("2", "1").Failed())
+	assert.True(t, calc("x", "1").IsFailure())
+	assert.True(t, calc("1", "x").IsFailure())
+
+	called := false
+	f := try.Bind2(try.Bind1(atoi("x")), func(a int) gs.Try[int] {
+		called = true
+		return gs.Success(a)
+	})
+	assert.True(t, try.Yield2(f, func(a, b int) int { return a + b }).IsFailure())
+	assert.False(t, called)
+}
+
+func TestComprehension6(t *testing.T) {
+	next := func(xs ...int) gs.Try[int] {
+		return gs.Success(len(xs) + 1)
+	}
+
+	f1 := try.Bind1(next())
+	f2 := try.Bind2(f1, func(a int) gs.Try[int] { return next(a) })
+	f3 := try.Bind3(f2, func(a, b int) gs.Try[int] { return next(a, b) })
+	f4 := try.Bind4(f3, func(a, b, c int) gs.Try[int] { return next(a, b, c) })
+	f5 := try.Bind5(f4, func(a, b, c, d int) gs.Try[int] { return next(a, b, c, d) })
+	f6 := try.Bind6(f5, func(a, b, c, d, e int) gs.Try[int] { return next(a, b, c, d, e) })
+
+	sum := try.Yield6(f6, func(a, b, c, d, e, f int) int { return a + b + c + d + e + f })
+	assert.Equal(t, 21, sum.Get())
+
+	sum = try.Yield6(f6.If(func(a, b, c, d, e, f int) bool { return f > 6 }), func(a, b, c, d, e, f int) int { return 0 })
+	assert.ErrorIs(t, sum.Failed(), gs.ErrUnsatisfied)
+	assert.Equal(t, gs.Unsatisfied(gs.T6(1, 2, 3, 4, 5, 6)), sum.Failed())
+}
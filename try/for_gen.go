@@ -0,0 +1,206 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gsgen -t for; DO NOT EDIT.
+
+package try
+
+import (
+	gs "github.com/kigichang/goscala"
+)
+
+// For1 is a for-comprehension after one step.
+type For1[A any] struct {
+	a   A
+	err error
+}
+
+// Bind1 starts a for-comprehension over Try. Each following BindN step receives
+// the values of all previous steps, and the chain stops at the first Failure.
+func Bind1[A any](t gs.Try[A]) For1[A] {
+	a, err := t.FetchErr()
+	return For1[A]{a: a, err: err}
+}
+
+// If is a guard. The comprehension fails with Unsatisfied of the rejected values if p does not hold.
+func (s For1[A]) If(p func(A) bool) For1[A] {
+	if s.err == nil && !p(s.a) {
+		s.err = gs.Unsatisfied(s.a)
+	}
+	return s
+}
+
+// Yield1 ends the comprehension with the result of fn, or the first Failure.
+func Yield1[A, R any](s For1[A], fn func(A) R) gs.Try[R] {
+	if s.err != nil {
+		return gs.Failure[R](s.err)
+	}
+	return gs.Success(fn(s.a))
+}
+
+// For2 is a for-comprehension after two steps.
+type For2[A, B any] struct {
+	a   A
+	b   B
+	err error
+}
+
+func Bind2[A, B any](s For1[A], fn func(A) gs.Try[B]) For2[A, B] {
+	if s.err != nil {
+		return For2[A, B]{err: s.err}
+	}
+	v, err := fn(s.a).FetchErr()
+	return For2[A, B]{a: s.a, b: v, err: err}
+}
+
+// If is a guard. The comprehension fails with Unsatisfied of the rejected values if p does not hold.
+func (s For2[A, B]) If(p func(A, B) bool) For2[A, B] {
+	if s.err == nil && !p(s.a, s.b) {
+		s.err = gs.Unsatisfied(gs.T2[A, B](s.a, s.b))
+	}
+	return s
+}
+
+// Yield2 ends the comprehension with the result of fn, or the first Failure.
+func Yield2[A, B, R any](s For2[A, B], fn func(A, B) R) gs.Try[R] {
+	if s.err != nil {
+		return gs.Failure[R](s.err)
+	}
+	return gs.Success(fn(s.a, s.b))
+}
+
+// For3 is a for-comprehension after three steps.
+type For3[A, B, C any] struct {
+	a   A
+	b   B
+	c   C
+	err error
+}
+
+func Bind3[A, B, C any](s For2[A, B], fn func(A, B) gs.Try[C]) For3[A, B, C] {
+	if s.err != nil {
+		return For3[A, B, C]{err: s.err}
+	}
+	v, err := fn(s.a, s.b).FetchErr()
+	return For3[A, B, C]{a: s.a, b: s.b, c: v, err: err}
+}
+
+// If is a guard. The comprehension fails with Unsatisfied of the rejected values if p does not hold.
+func (s For3[A, B, C]) If(p func(A, B, C) bool) For3[A, B, C] {
+	if s.err == nil && !p(s.a, s.b, s.c) {
+		s.err = gs.Unsatisfied(gs.T3[A, B, C](s.a, s.b, s.c))
+	}
+	return s
+}
+
+// Yield3 ends the comprehension with the result of fn, or the first Failure.
+func Yield3[A, B, C, R any](s For3[A, B, C], fn func(A, B, C) R) gs.Try[R] {
+	if s.err != nil {
+		return gs.Failure[R](s.err)
+	}
+	return gs.Success(fn(s.a, s.b, s.c))
+}
+
+// For4 is a for-comprehension after four steps.
+type For4[A, B, C, D any] struct {
+	a   A
+	b   B
+	c   C
+	d   D
+	err error
+}
+
+func Bind4[A, B, C, D any](s For3[A, B, C], fn func(A, B, C) gs.Try[D]) For4[A, B, C, D] {
+	if s.err != nil {
+		return For4[A, B, C, D]{err: s.err}
+	}
+	v, err := fn(s.a, s.b, s.c).FetchErr()
+	return For4[A, B, C, D]{a: s.a, b: s.b, c: s.c, d: v, err: err}
+}
+
+// If is a guard. The comprehension fails with Unsatisfied of the rejected values if p does not hold.
+func (s For4[A, B, C, D]) If(p func(A, B, C, D) bool) For4[A, B, C, D] {
+	if s.err == nil && !p(s.a, s.b, s.c, s.d) {
+		s.err = gs.Unsatisfied(gs.T4[A, B, C, D](s.a, s.b, s.c, s.d))
+	}
+	return s
+}
+
+// Yield4 ends the comprehension with the result of fn, or the first Failure.
+func Yield4[A, B, C, D, R any](s For4[A, B, C, D], fn func(A, B, C, D) R) gs.Try[R] {
+	if s.err != nil {
+		return gs.Failure[R](s.err)
+	}
+	return gs.Success(fn(s.a, s.b, s.c, s.d))
+}
+
+// For5 is a for-comprehension after five steps.
+type For5[A, B, C, D, E any] struct {
+	a   A
+	b   B
+	c   C
+	d   D
+	e   E
+	err error
+}
+
+func Bind5[A, B, C, D, E any](s For4[A, B, C, D], fn func(A, B, C, D) gs.Try[E]) For5[A, B, C, D, E] {
+	if s.err != nil {
+		return For5[A, B, C, D, E]{err: s.err}
+	}
+	v, err := fn(s.a, s.b, s.c, s.d).FetchErr()
+	return For5[A, B, C, D, E]{a: s.a, b: s.b, c: s.c, d: s.d, e: v, err: err}
+}
+
+// If is a guard. The comprehension fails with Unsatisfied of the rejected values if p does not hold.
+func (s For5[A, B, C, D, E]) If(p func(A, B, C, D, E) bool) For5[A, B, C, D, E] {
+	if s.err == nil && !p(s.a, s.b, s.c, s.d, s.e) {
+		s.err = gs.Unsatisfied(gs.T5[A, B, C, D, E](s.a, s.b, s.c, s.d, s.e))
+	}
+	return s
+}
+
+// Yield5 ends the comprehension with the result of fn, or the first Failure.
+func Yield5[A, B, C, D, E, R any](s For5[A, B, C, D, E], fn func(A, B, C, D, E) R) gs.Try[R] {
+	if s.err != nil {
+		return gs.Failure[R](s.err)
+	}
+	return gs.Success(fn(s.a, s.b, s.c, s.d, s.e))
+}
+
+// For6 is a for-comprehension after six steps.
+type For6[A, B, C, D, E, F any] struct {
+	a   A
+	b   B
+	c   C
+	d   D
+	e   E
+	f   F
+	err error
+}
+
+func Bind6[A, B, C, D, E, F any](s For5[A, B, C, D, E], fn func(A, B, C, D, E) gs.Try[F]) For6[A, B, C, D, E, F] {
+	if s.err != nil {
+		return For6[A, B, C, D, E, F]{err: s.err}
+	}
+	v, err := fn(s.a, s.b, s.c, s.d, s.e).FetchErr()
+	return For6[A, B, C, D, E, F]{a: s.a, b: s.b, c: s.c, d: s.d, e: s.e, f: v, err: err}
+}
+
+// If is a guard. The comprehension fails with Unsatisfied of the rejected values if p does not hold.
+func (s For6[A, B, C, D, E, F]) If(p func(A, B, C, D, E, F) bool) For6[A, B, C, D, E, F] {
+	if s.err == nil && !p(s.a, s.b, s.c, s.d, s.e, s.f) {
+		s.err = gs.Unsatisfied(gs.T6[A, B, C, D, E, F](s.a, s.b, s.c, s.d, s.e, s.f))
+	}
+	return s
+}
+
+// Yield6 ends the comprehension with the result of fn, or the first Failure.
+func Yield6[A, B, C, D, E, F, R any](s For6[A, B, C, D, E, F], fn func(A, B, C, D, E, F) R) gs.Try[R] {
+	if s.err != nil {
+		return gs.Failure[R](s.err)
+	}
+	return gs.Success(fn(s.a, s.b, s.c, s.d, s.e, s.f))
+}
@@ -0,0 +1,187 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gsgen -t mapn; DO NOT EDIT.
+
+package try
+
+import (
+	gs "github.com/kigichang/goscala"
+)
+
+// Map2 applies fn to the values of v1 and v2, or returns the first Failure.
+func Map2[A, B, R any](v1 gs.Try[A], v2 gs.Try[B], fn func(A, B) R) gs.Try[R] {
+	x1, err := v1.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x2, err := v2.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	return gs.Success(fn(x1, x2))
+}
+
+// Map3 applies fn to the values of v1, v2 and v3, or returns the first Failure.
+func Map3[A, B, C, R any](v1 gs.Try[A], v2 gs.Try[B], v3 gs.Try[C], fn func(A, B, C) R) gs.Try[R] {
+	x1, err := v1.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x2, err := v2.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x3, err := v3.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	return gs.Success(fn(x1, x2, x3))
+}
+
+// Map4 applies fn to the values of v1, v2, v3 and v4, or returns the first Failure.
+func Map4[A, B, C, D, R any](v1 gs.Try[A], v2 gs.Try[B], v3 gs.Try[C], v4 gs.Try[D], fn func(A, B, C, D) R) gs.Try[R] {
+	x1, err := v1.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x2, err := v2.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x3, err := v3.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x4, err := v4.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	return gs.Success(fn(x1, x2, x3, x4))
+}
+
+// Map5 applies fn to the values of v1, v2, v3, v4 and v5, or returns the first Failure.
+func Map5[A, B, C, D, E, R any](v1 gs.Try[A], v2 gs.Try[B], v3 gs.Try[C], v4 gs.Try[D], v5 gs.Try[E], fn func(A, B, C, D, E) R) gs.Try[R] {
+	x1, err := v1.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x2, err := v2.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x3, err := v3.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x4, err := v4.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x5, err := v5.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	return gs.Success(fn(x1, x2, x3, x4, x5))
+}
+
+// Map6 applies fn to the values of v1, v2, v3, v4, v5 and v6, or returns the first Failure.
+func Map6[A, B, C, D, E, F, R any](v1 gs.Try[A], v2 gs.Try[B], v3 gs.Try[C], v4 gs.Try[D], v5 gs.Try[E], v6 gs.Try[F], fn func(A, B, C, D, E, F) R) gs.Try[R] {
+	x1, err := v1.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x2, err := v2.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x3, err := v3.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x4, err := v4.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x5, err := v5.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x6, err := v6.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	return gs.Success(fn(x1, x2, x3, x4, x5, x6))
+}
+
+// Map7 applies fn to the values of v1, v2, v3, v4, v5, v6 and v7, or returns the first Failure.
+func Map7[A, B, C, D, E, F, G, R any](v1 gs.Try[A], v2 gs.Try[B], v3 gs.Try[C], v4 gs.Try[D], v5 gs.Try[E], v6 gs.Try[F], v7 gs.Try[G], fn func(A, B, C, D, E, F, G) R) gs.Try[R] {
+	x1, err := v1.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x2, err := v2.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x3, err := v3.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x4, err := v4.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x5, err := v5.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x6, err := v6.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x7, err := v7.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	return gs.Success(fn(x1, x2, x3, x4, x5, x6, x7))
+}
+
+// Map8 applies fn to the values of v1, v2, v3, v4, v5, v6, v7 and v8, or returns the first Failure.
+func Map8[A, B, C, D, E, F, G, H, R any](v1 gs.Try[A], v2 gs.Try[B], v3 gs.Try[C], v4 gs.Try[D], v5 gs.Try[E], v6 gs.Try[F], v7 gs.Try[G], v8 gs.Try[H], fn func(A, B, C, D, E, F, G, H) R) gs.Try[R] {
+	x1, err := v1.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x2, err := v2.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x3, err := v3.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x4, err := v4.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x5, err := v5.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x6, err := v6.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x7, err := v7.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	x8, err := v8.FetchErr()
+	if err != nil {
+		return gs.Failure[R](err)
+	}
+	return gs.Success(fn(x1, x2, x3, x4, x5, x6, x7, x8))
+}
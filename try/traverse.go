@@ -0,0 +1,77 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package try
+
+import (
+	"errors"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/iter"
+)
+
+// Traverse applies fn to every element of s and returns Success of all results,
+// or the first Failure.
+func Traverse[T, U any](s gs.Slice[T], fn func(T) gs.Try[U]) gs.Try[gs.Slice[U]] {
+	return TraverseIter(iter.Gen(s...), fn)
+}
+
+// TraverseIter is Traverse over an iterator. It stops consuming it at the first Failure.
+func TraverseIter[T, U any](it iter.Iter[T], fn func(T) gs.Try[U]) gs.Try[gs.Slice[U]] {
+	ret := make([]U, 0, it.Len())
+	for it.Next() {
+		v, err := fn(it.Get()).FetchErr()
+		if err != nil {
+			return gs.Failure[gs.Slice[U]](err)
+		}
+		ret = append(ret, v)
+	}
+	return gs.Success[gs.Slice[U]](ret)
+}
+
+// TraverseAll applies fn to every element of s and returns Success of all results,
+// or a Failure joining every error.
+func TraverseAll[T, U any](s gs.Slice[T], fn func(T) gs.Try[U]) gs.Try[gs.Slice[U]] {
+	return TraverseAllIter(iter.Gen(s...), fn)
+}
+
+// TraverseAllIter is TraverseAll over an iterator.
+func TraverseAllIter[T, U any](it iter.Iter[T], fn func(T) gs.Try[U]) gs.Try[gs.Slice[U]] {
+	ret := make([]U, 0, it.Len())
+	errs := []error{}
+	for it.Next() {
+		v, err := fn(it.Get()).FetchErr()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ret = append(ret, v)
+	}
+
+	if len(errs) > 0 {
+		return gs.Failure[gs.Slice[U]](errors.Join(errs...))
+	}
+	return gs.Success[gs.Slice[U]](ret)
+}
+
+// Sequence turns a slice of Trys into Success of all values, or the first Failure.
+func Sequence[T any](s gs.Slice[gs.Try[T]]) gs.Try[gs.Slice[T]] {
+	return Traverse(s, gs.Id[gs.Try[T]])
+}
+
+// SequenceIter is Sequence over an iterator. It stops consuming it at the first Failure.
+func SequenceIter[T any](it iter.Iter[gs.Try[T]]) gs.Try[gs.Slice[T]] {
+	return TraverseIter(it, gs.Id[gs.Try[T]])
+}
+
+// SequenceAll turns a slice of Trys into Success of all values, or a Failure joining every error.
+func SequenceAll[T any](s gs.Slice[gs.Try[T]]) gs.Try[gs.Slice[T]] {
+	return TraverseAll(s, gs.Id[gs.Try[T]])
+}
+
+// SequenceAllIter is SequenceAll over an iterator.
+func SequenceAllIter[T any](it iter.Iter[gs.Try[T]]) gs.Try[gs.Slice[T]] {
+	return TraverseAllIter(it, gs.Id[gs.Try[T]])
+}
@@ -0,0 +1,66 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package try_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/iter"
+	"github.com/kigichang/goscala/try"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraverse(t *testing.T) {
+	count := 0
+	atoi := func(s string) gs.Try[int] {
+		count++
+		return try.Err(strconv.Atoi(s))
+	}
+
+	tr := try.Traverse(gs.Slice[string]{"1", "2", "3"}, atoi)
+	assert.Equal(t, gs.Slice[int]{1, 2, 3}, tr.Get())
+	assert.Equal(t, 3, count)
+
+	count = 0
+	tr = try.TraverseIter(iter.Gen("1", "x", "y"), atoi)
+	assert.True(t, tr.IsFailure())
+	assert.Equal(t, 2, count)
+}
+
+func TestTraverseAll(t *testing.T) {
+	atoi := func(s string) gs.Try[int] {
+		return try.Err(strconv.Atoi(s))
+	}
+
+	tr := try.TraverseAll(gs.Slice[string]{"1", "2"}, atoi)
+	assert.Equal(t, gs.Slice[int]{1, 2}, tr.Get())
+
+	tr = try.TraverseAll(gs.Slice[string]{"x", "2", "y"}, atoi)
+	assert.True(t, tr.IsFailure())
+	assert.Equal(t, 2, len(tr.Failed().(interface{ Unwrap() []error }).Unwrap()))
+	assert.True(t, errors.Is(tr.Failed(), strconv.ErrSyntax))
+}
+
+func TestSequence(t *testing.T) {
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+
+	tr := try.Sequence(gs.Slice[gs.Try[int]]{gs.Success(1), gs.Success(2)})
+	assert.Equal(t, gs.Slice[int]{1, 2}, tr.Get())
+
+	tr = try.SequenceIter(iter.Gen(gs.Success(1), gs.Failure[int](err1), gs.Failure[int](err2)))
+	assert.Equal(t, err1, tr.Failed())
+
+	tr = try.SequenceAll(gs.Slice[gs.Try[int]]{gs.Failure[int](err1), gs.Success(1), gs.Failure[int](err2)})
+	assert.True(t, errors.Is(tr.Failed(), err1))
+	assert.True(t, errors.Is(tr.Failed(), err2))
+
+	tr = try.SequenceAllIter(iter.Gen(gs.Success(1), gs.Success(2)))
+	assert.Equal(t, gs.Slice[int]{1, 2}, tr.Get())
+}
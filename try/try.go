@@ -5,7 +5,13 @@
 
 package try
 
+//go:generate go run ../cmd/gsgen -t mapn -monad try -max 8 -o map_gen.go
+//go:generate go run ../cmd/gsgen -t zip -monad try -max 8 -o zip_gen.go
+//go:generate go run ../cmd/gsgen -t for -monad try -max 6 -o for_gen.go
+
 import (
+	"encoding/json"
+
 	gs "github.com/kigichang/goscala"
 )
 
@@ -17,7 +23,10 @@ func Err[T any](v T, err error) gs.Try[T] {
 }
 
 func Bool[T any](v T, ok bool) gs.Try[T] {
-	return Err(v, gs.Cond(ok, nil, gs.ErrUnsatisfied))
+	if ok {
+		return gs.Success(v)
+	}
+	return gs.Failure[T](gs.Unsatisfied(v))
 }
 
 func Collect[T, U any](t gs.Try[T], pf func(T) (U, bool)) gs.Try[U] {
@@ -68,3 +77,22 @@ func Transform[T, U any](t gs.Try[T], succ func(T) gs.Try[U], fail func(error) g
 		fail,
 	)(t.FetchErr)
 }
+
+// Unmarshal decodes an object holding exactly one of "success" or "failure".
+func Unmarshal[T any](data []byte) (gs.Try[T], error) {
+	var z T
+	t := gs.Success[T](z)
+	if err := json.Unmarshal(data, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Either returns Right of the success value, or Left of the failure mapped by fn.
+func Either[L, T any](t gs.Try[T], fn func(error) L) gs.Either[L, T] {
+	return Fold(
+		t,
+		gs.Right[L, T],
+		gs.FuncAndThen(fn, gs.Left[L, T]),
+	)
+}
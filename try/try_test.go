@@ -6,6 +6,7 @@
 package try_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"testing"
@@ -57,7 +58,7 @@ func TestCollect(t *testing.T) {
 	tr = gs.Success(-1)
 	tr2 = try.Collect(tr, pf)
 	assert.True(t, tr2.IsFailure())
-	assert.Equal(t, gs.ErrUnsatisfied, tr2.Failed())
+	assert.ErrorIs(t, tr2.Failed(), gs.ErrUnsatisfied)
 }
 
 func TestFlatMap(t *testing.T) {
@@ -141,7 +142,7 @@ func TestMapBool(t *testing.T) {
 
 	tr = try.MapBool(gs.Success(2), f)
 	assert.True(t, tr.IsFailure())
-	assert.Equal(t, gs.ErrUnsatisfied, tr.Failed())
+	assert.ErrorIs(t, tr.Failed(), gs.ErrUnsatisfied)
 }
 
 func TestTryTransform(t *testing.T) {
@@ -162,3 +163,43 @@ func TestTryTransform(t *testing.T) {
 	assert.True(t, ans.IsFailure())
 	assert.Equal(t, gs.ErrEmpty, ans.Failed())
 }
+
+func TestUnmarshal(t *testing.T) {
+	tr, err := try.Unmarshal[int]([]byte(`{"success":1}`))
+	assert.Nil(t, err)
+	assert.True(t, tr.IsSuccess())
+	assert.Equal(t, 1, tr.Get())
+
+	tr, err = try.Unmarshal[int]([]byte(`{"failure":"oops"}`))
+	assert.Nil(t, err)
+	assert.True(t, tr.IsFailure())
+	assert.Equal(t, "oops", tr.Failed().Error())
+
+	_, err = try.Unmarshal[int]([]byte(`{"success":1,"failure":"oops"}`))
+	assert.NotNil(t, err)
+
+	_, err = try.Unmarshal[int]([]byte(`{}`))
+	assert.NotNil(t, err)
+
+	x := gs.Failure[string](fmt.Errorf("oops"))
+	data, err := json.Marshal(x)
+	assert.Nil(t, err)
+	y, err := try.Unmarshal[string](data)
+	assert.Nil(t, err)
+	assert.Equal(t, x.String(), y.String())
+}
+
+func TestEither(t *testing.T) {
+	err := fmt.Errorf("oops")
+	msg := func(err error) string { return err.Error() }
+
+	e := try.Either(gs.Success(1), msg)
+	assert.Equal(t, 1, e.Right())
+
+	e = try.Either(gs.Failure[int](err), msg)
+	assert.Equal(t, "oops", e.Left())
+
+	// Try -> Either[error, T] -> Try keeps the same error.
+	tr := try.Either(gs.Failure[int](err), gs.Id[error]).Try()
+	assert.Equal(t, err, tr.Failed())
+}
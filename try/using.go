@@ -0,0 +1,84 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package try
+
+import (
+	"errors"
+	"io"
+
+	gs "github.com/kigichang/goscala"
+)
+
+// Bracket acquires a resource, applies use to it and releases it, even if use panics.
+// release is not called if acquire fails.
+// A panic is returned as Failure. The error of use is kept as is if release succeeds,
+// and errors of use and release are joined if both fail.
+func Bracket[R, T any](acquire func() (R, error), use func(R) (T, error), release func(R) error) gs.Try[T] {
+	r, err := safe(acquire)
+	if err != nil {
+		var ret T
+		return Err(ret, err)
+	}
+
+	ret, err := safe(func() (T, error) {
+		return use(r)
+	})
+	_, rerr := safe(func() (gs.UnitRef, error) {
+		return gs.Unit(), release(r)
+	})
+	return Err(ret, join(err, rerr))
+}
+
+// Using is Bracket with Close as release.
+func Using[R io.Closer, T any](acquire func() (R, error), use func(R) (T, error)) gs.Try[T] {
+	return Bracket(acquire, use, R.Close)
+}
+
+// UsingAll acquires resources in order, applies use to them and closes them in reverse order.
+// If an acquire fails, the resources acquired so far are closed and use is not called.
+func UsingAll[R io.Closer, T any](use func([]R) (T, error), acquire ...func() (R, error)) gs.Try[T] {
+	rs := make([]R, 0, len(acquire))
+	ret, err := safe(func() (ret T, err error) {
+		for i := range acquire {
+			r, err := acquire[i]()
+			if err != nil {
+				return ret, err
+			}
+			rs = append(rs, r)
+		}
+		return use(rs)
+	})
+
+	for i := len(rs) - 1; i >= 0; i-- {
+		_, cerr := safe(func() (gs.UnitRef, error) {
+			return gs.Unit(), rs[i].Close()
+		})
+		err = join(err, cerr)
+	}
+	return Err(ret, err)
+}
+
+// join returns the error that is not nil, or joins err and rerr if both are not nil.
+func join(err, rerr error) error {
+	switch {
+	case rerr == nil:
+		return err
+	case err == nil:
+		return rerr
+	default:
+		return errors.Join(err, rerr)
+	}
+}
+
+// safe calls fn and returns a panic as error.
+func safe[T any](fn func() (T, error)) (ret T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = gs.PanicErr(r)
+		}
+	}()
+	return fn()
+}
@@ -0,0 +1,122 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package try_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kigichang/goscala/try"
+	"github.com/stretchr/testify/assert"
+)
+
+type resource struct {
+	name string
+	log  *[]string
+	err  error
+}
+
+func (r *resource) Close() error {
+	*r.log = append(*r.log, "close "+r.name)
+	return r.err
+}
+
+func open(log *[]string, name string, err error) func() (*resource, error) {
+	return func() (*resource, error) {
+		if name == "" {
+			return nil, err
+		}
+		*log = append(*log, "open "+name)
+		return &resource{name: name, log: log, err: err}, nil
+	}
+}
+
+func TestUsing(t *testing.T) {
+	var log []string
+	errUse := errors.New("use")
+	errClose := errors.New("close")
+
+	v := try.Using(open(&log, "a", nil), func(r *resource) (string, error) {
+		return r.name, nil
+	})
+	assert.Equal(t, "a", v.Get())
+	assert.Equal(t, []string{"open a", "close a"}, log)
+
+	log = nil
+	v = try.Using(open(&log, "a", errClose), func(r *resource) (string, error) {
+		return "", errUse
+	})
+	assert.ErrorIs(t, v.Failed(), errUse)
+	assert.ErrorIs(t, v.Failed(), errClose)
+
+	log = nil
+	v = try.Using(open(&log, "a", nil), func(r *resource) (string, error) {
+		return "", errUse
+	})
+	assert.Equal(t, errUse, v.Failed())
+
+	log = nil
+	v = try.Using(open(&log, "a", errClose), func(r *resource) (string, error) {
+		return r.name, nil
+	})
+	assert.Equal(t, errClose, v.Failed())
+
+	log = nil
+	v = try.Using(open(&log, "a", nil), func(r *resource) (string, error) {
+		panic("boom")
+	})
+	assert.EqualError(t, v.Failed(), "boom")
+	assert.Equal(t, []string{"open a", "close a"}, log)
+
+	log = nil
+	v = try.Using(open(&log, "", errUse), func(r *resource) (string, error) {
+		return r.name, nil
+	})
+	assert.ErrorIs(t, v.Failed(), errUse)
+	assert.Nil(t, log)
+}
+
+func TestUsingAll(t *testing.T) {
+	var log []string
+	errOpen := errors.New("open")
+
+	v := try.UsingAll(func(rs []*resource) (int, error) {
+		return len(rs), nil
+	}, open(&log, "a", nil), open(&log, "b", nil), open(&log, "c", nil))
+	assert.Equal(t, 3, v.Get())
+	assert.Equal(t, []string{"open a", "open b", "open c", "close c", "close b", "close a"}, log)
+
+	log = nil
+	called := false
+	v = try.UsingAll(func(rs []*resource) (int, error) {
+		called = true
+		return len(rs), nil
+	}, open(&log, "a", nil), open(&log, "", errOpen), open(&log, "c", nil))
+	assert.Equal(t, errOpen, v.Failed())
+	assert.False(t, called)
+	assert.Equal(t, []string{"open a", "close a"}, log)
+
+	log = nil
+	v = try.UsingAll(func(rs []*resource) (int, error) {
+		panic(errOpen)
+	}, open(&log, "a", nil), open(&log, "b", nil))
+	assert.ErrorIs(t, v.Failed(), errOpen)
+	assert.Equal(t, []string{"open a", "open b", "close b", "close a"}, log)
+}
+
+func TestBracket(t *testing.T) {
+	released := 0
+	v := try.Bracket(func() (int, error) {
+		return 1, nil
+	}, func(r int) (int, error) {
+		return r + 1, nil
+	}, func(int) error {
+		released++
+		return nil
+	})
+	assert.Equal(t, 2, v.Get())
+	assert.Equal(t, 1, released)
+}
@@ -0,0 +1,187 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gsgen -t zip; DO NOT EDIT.
+
+package try
+
+import (
+	gs "github.com/kigichang/goscala"
+)
+
+// Zip tuples the values of v1 and v2, or returns the first Failure.
+func Zip[A, B any](v1 gs.Try[A], v2 gs.Try[B]) gs.Try[gs.Tuple2[A, B]] {
+	x1, err := v1.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple2[A, B]](err)
+	}
+	x2, err := v2.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple2[A, B]](err)
+	}
+	return gs.Success(gs.T2[A, B](x1, x2))
+}
+
+// Zip3 tuples the values of v1, v2 and v3, or returns the first Failure.
+func Zip3[A, B, C any](v1 gs.Try[A], v2 gs.Try[B], v3 gs.Try[C]) gs.Try[gs.Tuple3[A, B, C]] {
+	x1, err := v1.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple3[A, B, C]](err)
+	}
+	x2, err := v2.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple3[A, B, C]](err)
+	}
+	x3, err := v3.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple3[A, B, C]](err)
+	}
+	return gs.Success(gs.T3[A, B, C](x1, x2, x3))
+}
+
+// Zip4 tuples the values of v1, v2, v3 and v4, or returns the first Failure.
+func Zip4[A, B, C, D any](v1 gs.Try[A], v2 gs.Try[B], v3 gs.Try[C], v4 gs.Try[D]) gs.Try[gs.Tuple4[A, B, C, D]] {
+	x1, err := v1.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple4[A, B, C, D]](err)
+	}
+	x2, err := v2.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple4[A, B, C, D]](err)
+	}
+	x3, err := v3.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple4[A, B, C, D]](err)
+	}
+	x4, err := v4.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple4[A, B, C, D]](err)
+	}
+	return gs.Success(gs.T4[A, B, C, D](x1, x2, x3, x4))
+}
+
+// Zip5 tuples the values of v1, v2, v3, v4 and v5, or returns the first Failure.
+func Zip5[A, B, C, D, E any](v1 gs.Try[A], v2 gs.Try[B], v3 gs.Try[C], v4 gs.Try[D], v5 gs.Try[E]) gs.Try[gs.Tuple5[A, B, C, D, E]] {
+	x1, err := v1.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple5[A, B, C, D, E]](err)
+	}
+	x2, err := v2.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple5[A, B, C, D, E]](err)
+	}
+	x3, err := v3.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple5[A, B, C, D, E]](err)
+	}
+	x4, err := v4.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple5[A, B, C, D, E]](err)
+	}
+	x5, err := v5.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple5[A, B, C, D, E]](err)
+	}
+	return gs.Success(gs.T5[A, B, C, D, E](x1, x2, x3, x4, x5))
+}
+
+// Zip6 tuples the values of v1, v2, v3, v4, v5 and v6, or returns the first Failure.
+func Zip6[A, B, C, D, E, F any](v1 gs.Try[A], v2 gs.Try[B], v3 gs.Try[C], v4 gs.Try[D], v5 gs.Try[E], v6 gs.Try[F]) gs.Try[gs.Tuple6[A, B, C, D, E, F]] {
+	x1, err := v1.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple6[A, B, C, D, E, F]](err)
+	}
+	x2, err := v2.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple6[A, B, C, D, E, F]](err)
+	}
+	x3, err := v3.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple6[A, B, C, D, E, F]](err)
+	}
+	x4, err := v4.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple6[A, B, C, D, E, F]](err)
+	}
+	x5, err := v5.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple6[A, B, C, D, E, F]](err)
+	}
+	x6, err := v6.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple6[A, B, C, D, E, F]](err)
+	}
+	return gs.Success(gs.T6[A, B, C, D, E, F](x1, x2, x3, x4, x5, x6))
+}
+
+// Zip7 tuples the values of v1, v2, v3, v4, v5, v6 and v7, or returns the first Failure.
+func Zip7[A, B, C, D, E, F, G any](v1 gs.Try[A], v2 gs.Try[B], v3 gs.Try[C], v4 gs.Try[D], v5 gs.Try[E], v6 gs.Try[F], v7 gs.Try[G]) gs.Try[gs.Tuple7[A, B, C, D, E, F, G]] {
+	x1, err := v1.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple7[A, B, C, D, E, F, G]](err)
+	}
+	x2, err := v2.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple7[A, B, C, D, E, F, G]](err)
+	}
+	x3, err := v3.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple7[A, B, C, D, E, F, G]](err)
+	}
+	x4, err := v4.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple7[A, B, C, D, E, F, G]](err)
+	}
+	x5, err := v5.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple7[A, B, C, D, E, F, G]](err)
+	}
+	x6, err := v6.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple7[A, B, C, D, E, F, G]](err)
+	}
+	x7, err := v7.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple7[A, B, C, D, E, F, G]](err)
+	}
+	return gs.Success(gs.T7[A, B, C, D, E, F, G](x1, x2, x3, x4, x5, x6, x7))
+}
+
+// Zip8 tuples the values of v1, v2, v3, v4, v5, v6, v7 and v8, or returns the first Failure.
+func Zip8[A, B, C, D, E, F, G, H any](v1 gs.Try[A], v2 gs.Try[B], v3 gs.Try[C], v4 gs.Try[D], v5 gs.Try[E], v6 gs.Try[F], v7 gs.Try[G], v8 gs.Try[H]) gs.Try[gs.Tuple8[A, B, C, D, E, F, G, H]] {
+	x1, err := v1.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple8[A, B, C, D, E, F, G, H]](err)
+	}
+	x2, err := v2.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple8[A, B, C, D, E, F, G, H]](err)
+	}
+	x3, err := v3.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple8[A, B, C, D, E, F, G, H]](err)
+	}
+	x4, err := v4.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple8[A, B, C, D, E, F, G, H]](err)
+	}
+	x5, err := v5.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple8[A, B, C, D, E, F, G, H]](err)
+	}
+	x6, err := v6.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple8[A, B, C, D, E, F, G, H]](err)
+	}
+	x7, err := v7.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple8[A, B, C, D, E, F, G, H]](err)
+	}
+	x8, err := v8.FetchErr()
+	if err != nil {
+		return gs.Failure[gs.Tuple8[A, B, C, D, E, F, G, H]](err)
+	}
+	return gs.Success(gs.T8[A, B, C, D, E, F, G, H](x1, x2, x3, x4, x5, x6, x7, x8))
+}
@@ -0,0 +1,51 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package try_test
+
+import (
+	"errors"
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/try"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip(t *testing.T) {
+	err := errors.New("oops")
+
+	tr := try.Zip(gs.Success(1), gs.Success("a"))
+	assert.Equal(t, 1, tr.Get().V1())
+	assert.Equal(t, "a", tr.Get().V2())
+
+	assert.Equal(t, err, try.Zip(gs.Success(1), gs.Failure[string](err)).Failed())
+
+	assert.Equal(t, gs.T3(1, "a", true), try.Zip3(gs.Success(1), gs.Success("a"), gs.Success(true)).Get())
+	assert.Equal(t, err, try.Zip3(gs.Success(1), gs.Success("a"), gs.Failure[bool](err)).Failed())
+}
+
+func TestMapN(t *testing.T) {
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+
+	add2 := func(a, b int) int { return a + b }
+	add3 := func(a, b, c int) int { return a + b + c }
+	add4 := func(a, b, c, d int) int { return a + b + c + d }
+	add5 := func(a, b, c, d, e int) int { return a + b + c + d + e }
+
+	one := gs.Success(1)
+	f1 := gs.Failure[int](err1)
+	f2 := gs.Failure[int](err2)
+
+	assert.Equal(t, 2, try.Map2(one, one, add2).Get())
+	assert.Equal(t, err1, try.Map2(f1, f2, add2).Failed())
+	assert.Equal(t, 3, try.Map3(one, one, one, add3).Get())
+	assert.Equal(t, err2, try.Map3(one, f2, f1, add3).Failed())
+	assert.Equal(t, 4, try.Map4(one, one, one, one, add4).Get())
+	assert.Equal(t, err1, try.Map4(one, one, one, f1, add4).Failed())
+	assert.Equal(t, 5, try.Map5(one, one, one, one, one, add5).Get())
+	assert.Equal(t, err2, try.Map5(one, one, f2, one, f1, add5).Failed())
+}
@@ -6,6 +6,7 @@
 package goscala_test
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
@@ -90,7 +91,8 @@ func TestTryFilter(t *testing.T) {
 	tr = goscala.Success(-1)
 	tr2 = tr.Filter(predict)
 	assert.True(t, tr2.IsFailure())
-	assert.Equal(t, goscala.ErrUnsatisfied, tr2.Failed())
+	assert.ErrorIs(t, tr2.Failed(), goscala.ErrUnsatisfied)
+	assert.Equal(t, -1, tr2.Failed().(*goscala.UnsatisfiedError).Value)
 
 	err := fmt.Errorf("tr filter error")
 	tr = goscala.Failure[int](err)
@@ -171,3 +173,27 @@ func TestTryRecoverWith(t *testing.T) {
 	assert.Equal(t, err, goscala.Failure[int](err).RecoverWith(r).Failed())
 
 }
+
+func TestTryJSON(t *testing.T) {
+	data, err := json.Marshal(goscala.Success(1))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"success":1}`, string(data))
+
+	data, err = json.Marshal(goscala.Failure[int](fmt.Errorf("oops")))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"failure":"oops"}`, string(data))
+}
+
+func TestTryEither(t *testing.T) {
+	err := fmt.Errorf("oops")
+
+	e := goscala.Success(1).Either()
+	assert.True(t, e.IsRight())
+	assert.Equal(t, 1, e.Right())
+	assert.Equal(t, 1, e.Try().Get())
+
+	e = goscala.Failure[int](err).Either()
+	assert.True(t, e.IsLeft())
+	assert.Equal(t, err, e.Left())
+	assert.Equal(t, err, e.Try().Failed())
+}
@@ -0,0 +1,11 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package goscala
+
+// Swap returns the tuple with the elements exchanged.
+func (t Tuple2[A, B]) Swap() Tuple2[B, A] {
+	return T2(t.v2, t.v1)
+}
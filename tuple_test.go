@@ -0,0 +1,27 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package goscala_test
+
+import (
+	"strconv"
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTuple(t *testing.T) {
+	tp := gs.T2(1, "a")
+	assert.Equal(t, gs.T2("a", 1), tp.Swap())
+	assert.Equal(t, gs.T2("1", "a"), gs.Tuple2MapV1(tp, strconv.Itoa))
+	assert.Equal(t, gs.T2(1, 1), gs.Tuple2MapV2(tp, func(s string) int { return len(s) }))
+
+	t6 := gs.T6(1, 2, 3, 4, 5, 6)
+	a, b, c, d, e, f := gs.Tuple6MapV4(t6, strconv.Itoa).Get()
+	assert.Equal(t, []any{1, 2, 3, "4", 5, 6}, []any{a, b, c, d, e, f})
+
+	assert.True(t, gs.T3(1, "a", true) == gs.T3(1, "a", true))
+}
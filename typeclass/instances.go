@@ -0,0 +1,163 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package typeclass
+
+//go:generate go run ../cmd/gsgen -t tupleclass -max 8 -o tuple_gen.go
+
+import (
+	"cmp"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/maps"
+)
+
+// Equal is the Eq of comparable values using ==.
+func Equal[T comparable]() Eq[T] {
+	return EqFrom(gs.Eq[T])
+}
+
+// Ordered is the natural Ord of numerics and strings.
+func Ordered[T cmp.Ordered]() Ord[T] {
+	return OrdFrom(gs.Compare[T])
+}
+
+func Sum[T gs.Numeric]() Monoid[T] {
+	return MonoidFrom(
+		func(a, b T) T { return a + b },
+		func() T { return 0 },
+	)
+}
+
+func Product[T gs.Numeric]() Monoid[T] {
+	return MonoidFrom(
+		func(a, b T) T { return a * b },
+		func() T { return 1 },
+	)
+}
+
+// Concat is the Monoid of string concatenation.
+func Concat[T ~string]() Monoid[T] {
+	return MonoidFrom(
+		func(a, b T) T { return a + b },
+		func() (z T) { return },
+	)
+}
+
+func SliceEq[T any](e Eq[T]) Eq[gs.Slice[T]] {
+	return EqFrom(func(a, b gs.Slice[T]) bool {
+		return a.Equals(e.Equal)(b)
+	})
+}
+
+// SliceOrd orders slices lexicographically.
+func SliceOrd[T any](o Ord[T]) Ord[gs.Slice[T]] {
+	return OrdFrom(func(a, b gs.Slice[T]) int {
+		size := gs.Min(a.Len(), b.Len())
+		for i := 0; i < size; i++ {
+			if ret := o.Compare(a[i], b[i]); ret != 0 {
+				return ret
+			}
+		}
+		return gs.Compare(a.Len(), b.Len())
+	})
+}
+
+// SliceMonoid is the Monoid of slice concatenation.
+func SliceMonoid[T any]() Monoid[gs.Slice[T]] {
+	return MonoidFrom(
+		func(a, b gs.Slice[T]) gs.Slice[T] {
+			ret := make([]T, 0, a.Len()+b.Len())
+			ret = append(ret, a...)
+			return append(ret, b...)
+		},
+		func() gs.Slice[T] { return gs.SliceEmpty[T]() },
+	)
+}
+
+func OptionEq[T any](e Eq[T]) Eq[gs.Option[T]] {
+	return EqFrom(func(a, b gs.Option[T]) bool {
+		return a.Equals(e.Equal)(b)
+	})
+}
+
+// OptionOrd orders None before any Some.
+func OptionOrd[T any](o Ord[T]) Ord[gs.Option[T]] {
+	return OrdFrom(func(a, b gs.Option[T]) int {
+		x, ok1 := a.Fetch()
+		y, ok2 := b.Fetch()
+		if ok1 && ok2 {
+			return o.Compare(x, y)
+		}
+		return gs.Compare(gs.Cond(ok1, 1, 0), gs.Cond(ok2, 1, 0))
+	})
+}
+
+// OptionMonoid combines the values of two Somes with s, and treats None as the identity.
+func OptionMonoid[T any](s Semigroup[T]) Monoid[gs.Option[T]] {
+	return MonoidFrom(
+		func(a, b gs.Option[T]) gs.Option[T] {
+			x, ok1 := a.Fetch()
+			y, ok2 := b.Fetch()
+			if ok1 && ok2 {
+				return gs.Some(s.Combine(x, y))
+			}
+			return a.OrElse(b)
+		},
+		gs.None[T],
+	)
+}
+
+// MapEq compares maps by their keys and the values under e.
+func MapEq[K comparable, V any](e Eq[V]) Eq[gs.Map[K, V]] {
+	return EqFrom(func(a, b gs.Map[K, V]) bool {
+		if a.Len() != b.Len() {
+			return false
+		}
+		return a.Forall(func(k K, v V) bool {
+			v2, ok := b.Get(k)
+			return ok && e.Equal(v, v2)
+		})
+	})
+}
+
+// MapMonoid merges maps into a new one, combining the values of common keys with s.
+func MapMonoid[K comparable, V any](s Semigroup[V]) Monoid[gs.Map[K, V]] {
+	return MonoidFrom(
+		func(a, b gs.Map[K, V]) gs.Map[K, V] {
+			ret := maps.Make[K, V](a.Len() + b.Len())
+			a.Foreach(ret.Put)
+			b.Foreach(func(k K, v V) {
+				if v1, ok := ret.Get(k); ok {
+					v = s.Combine(v1, v)
+				}
+				ret.Put(k, v)
+			})
+			return ret
+		},
+		maps.Empty[K, V],
+	)
+}
+
+func PairEq[K comparable, V any](ek Eq[K], ev Eq[V]) Eq[gs.Pair[K, V]] {
+	return EqFrom(func(a, b gs.Pair[K, V]) bool {
+		return ek.Equal(a.Key(), b.Key()) && ev.Equal(a.Value(), b.Value())
+	})
+}
+
+// PairOrd orders pairs lexicographically, by key then by value.
+func PairOrd[K comparable, V any](ok Ord[K], ov Ord[V]) Ord[gs.Pair[K, V]] {
+	return Then(
+		OrdBy(gs.Pair[K, V].Key, ok),
+		OrdBy(gs.Pair[K, V].Value, ov),
+	)
+}
+
+// PairSemigroup combines pairs element-wise.
+func PairSemigroup[K comparable, V any](sk Semigroup[K], sv Semigroup[V]) Semigroup[gs.Pair[K, V]] {
+	return SemigroupFrom(func(a, b gs.Pair[K, V]) gs.Pair[K, V] {
+		return gs.P(sk.Combine(a.Key(), b.Key()), sv.Combine(a.Value(), b.Value()))
+	})
+}
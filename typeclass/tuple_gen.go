@@ -0,0 +1,166 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gsgen -t tupleclass; DO NOT EDIT.
+
+package typeclass
+
+import (
+	gs "github.com/kigichang/goscala"
+)
+
+// Tuple2Eq compares tuples element-wise.
+func Tuple2Eq[A, B any](e1 Eq[A], e2 Eq[B]) Eq[gs.Tuple2[A, B]] {
+	return EqFrom(func(a, b gs.Tuple2[A, B]) bool {
+		return e1.Equal(a.V1(), b.V1()) &&
+			e2.Equal(a.V2(), b.V2())
+	})
+}
+
+// Tuple2Ord orders tuples lexicographically.
+func Tuple2Ord[A, B any](o1 Ord[A], o2 Ord[B]) Ord[gs.Tuple2[A, B]] {
+	return Then(
+		OrdBy(gs.Tuple2[A, B].V1, o1),
+		OrdBy(gs.Tuple2[A, B].V2, o2),
+	)
+}
+
+// Tuple3Eq compares tuples element-wise.
+func Tuple3Eq[A, B, C any](e1 Eq[A], e2 Eq[B], e3 Eq[C]) Eq[gs.Tuple3[A, B, C]] {
+	return EqFrom(func(a, b gs.Tuple3[A, B, C]) bool {
+		return e1.Equal(a.V1(), b.V1()) &&
+			e2.Equal(a.V2(), b.V2()) &&
+			e3.Equal(a.V3(), b.V3())
+	})
+}
+
+// Tuple3Ord orders tuples lexicographically.
+func Tuple3Ord[A, B, C any](o1 Ord[A], o2 Ord[B], o3 Ord[C]) Ord[gs.Tuple3[A, B, C]] {
+	return Then(
+		OrdBy(gs.Tuple3[A, B, C].V1, o1),
+		OrdBy(gs.Tuple3[A, B, C].V2, o2),
+		OrdBy(gs.Tuple3[A, B, C].V3, o3),
+	)
+}
+
+// Tuple4Eq compares tuples element-wise.
+func Tuple4Eq[A, B, C, D any](e1 Eq[A], e2 Eq[B], e3 Eq[C], e4 Eq[D]) Eq[gs.Tuple4[A, B, C, D]] {
+	return EqFrom(func(a, b gs.Tuple4[A, B, C, D]) bool {
+		return e1.Equal(a.V1(), b.V1()) &&
+			e2.Equal(a.V2(), b.V2()) &&
+			e3.Equal(a.V3(), b.V3()) &&
+			e4.Equal(a.V4(), b.V4())
+	})
+}
+
+// Tuple4Ord orders tuples lexicographically.
+func Tuple4Ord[A, B, C, D any](o1 Ord[A], o2 Ord[B], o3 Ord[C], o4 Ord[D]) Ord[gs.Tuple4[A, B, C, D]] {
+	return Then(
+		OrdBy(gs.Tuple4[A, B, C, D].V1, o1),
+		OrdBy(gs.Tuple4[A, B, C, D].V2, o2),
+		OrdBy(gs.Tuple4[A, B, C, D].V3, o3),
+		OrdBy(gs.Tuple4[A, B, C, D].V4, o4),
+	)
+}
+
+// Tuple5Eq compares tuples element-wise.
+func Tuple5Eq[A, B, C, D, E any](e1 Eq[A], e2 Eq[B], e3 Eq[C], e4 Eq[D], e5 Eq[E]) Eq[gs.Tuple5[A, B, C, D, E]] {
+	return EqFrom(func(a, b gs.Tuple5[A, B, C, D, E]) bool {
+		return e1.Equal(a.V1(), b.V1()) &&
+			e2.Equal(a.V2(), b.V2()) &&
+			e3.Equal(a.V3(), b.V3()) &&
+			e4.Equal(a.V4(), b.V4()) &&
+			e5.Equal(a.V5(), b.V5())
+	})
+}
+
+// Tuple5Ord orders tuples lexicographically.
+func Tuple5Ord[A, B, C, D, E any](o1 Ord[A], o2 Ord[B], o3 Ord[C], o4 Ord[D], o5 Ord[E]) Ord[gs.Tuple5[A, B, C, D, E]] {
+	return Then(
+		OrdBy(gs.Tuple5[A, B, C, D, E].V1, o1),
+		OrdBy(gs.Tuple5[A, B, C, D, E].V2, o2),
+		OrdBy(gs.Tuple5[A, B, C, D, E].V3, o3),
+		OrdBy(gs.Tuple5[A, B, C, D, E].V4, o4),
+		OrdBy(gs.Tuple5[A, B, C, D, E].V5, o5),
+	)
+}
+
+// Tuple6Eq compares tuples element-wise.
+func Tuple6Eq[A, B, C, D, E, F any](e1 Eq[A], e2 Eq[B], e3 Eq[C], e4 Eq[D], e5 Eq[E], e6 Eq[F]) Eq[gs.Tuple6[A, B, C, D, E, F]] {
+	return EqFrom(func(a, b gs.Tuple6[A, B, C, D, E, F]) bool {
+		return e1.Equal(a.V1(), b.V1()) &&
+			e2.Equal(a.V2(), b.V2()) &&
+			e3.Equal(a.V3(), b.V3()) &&
+			e4.Equal(a.V4(), b.V4()) &&
+			e5.Equal(a.V5(), b.V5()) &&
+			e6.Equal(a.V6(), b.V6())
+	})
+}
+
+// Tuple6Ord orders tuples lexicographically.
+func Tuple6Ord[A, B, C, D, E, F any](o1 Ord[A], o2 Ord[B], o3 Ord[C], o4 Ord[D], o5 Ord[E], o6 Ord[F]) Ord[gs.Tuple6[A, B, C, D, E, F]] {
+	return Then(
+		OrdBy(gs.Tuple6[A, B, C, D, E, F].V1, o1),
+		OrdBy(gs.Tuple6[A, B, C, D, E, F].V2, o2),
+		OrdBy(gs.Tuple6[A, B, C, D, E, F].V3, o3),
+		OrdBy(gs.Tuple6[A, B, C, D, E, F].V4, o4),
+		OrdBy(gs.Tuple6[A, B, C, D, E, F].V5, o5),
+		OrdBy(gs.Tuple6[A, B, C, D, E, F].V6, o6),
+	)
+}
+
+// Tuple7Eq compares tuples element-wise.
+func Tuple7Eq[A, B, C, D, E, F, G any](e1 Eq[A], e2 Eq[B], e3 Eq[C], e4 Eq[D], e5 Eq[E], e6 Eq[F], e7 Eq[G]) Eq[gs.Tuple7[A, B, C, D, E, F, G]] {
+	return EqFrom(func(a, b gs.Tuple7[A, B, C, D, E, F, G]) bool {
+		return e1.Equal(a.V1(), b.V1()) &&
+			e2.Equal(a.V2(), b.V2()) &&
+			e3.Equal(a.V3(), b.V3()) &&
+			e4.Equal(a.V4(), b.V4()) &&
+			e5.Equal(a.V5(), b.V5()) &&
+			e6.Equal(a.V6(), b.V6()) &&
+			e7.Equal(a.V7(), b.V7())
+	})
+}
+
+// Tuple7Ord orders tuples lexicographically.
+func Tuple7Ord[A, B, C, D, E, F, G any](o1 Ord[A], o2 Ord[B], o3 Ord[C], o4 Ord[D], o5 Ord[E], o6 Ord[F], o7 Ord[G]) Ord[gs.Tuple7[A, B, C, D, E, F, G]] {
+	return Then(
+		OrdBy(gs.Tuple7[A, B, C, D, E, F, G].V1, o1),
+		OrdBy(gs.Tuple7[A, B, C, D, E, F, G].V2, o2),
+		OrdBy(gs.Tuple7[A, B, C, D, E, F, G].V3, o3),
+		OrdBy(gs.Tuple7[A, B, C, D, E, F, G].V4, o4),
+		OrdBy(gs.Tuple7[A, B, C, D, E, F, G].V5, o5),
+		OrdBy(gs.Tuple7[A, B, C, D, E, F, G].V6, o6),
+		OrdBy(gs.Tuple7[A, B, C, D, E, F, G].V7, o7),
+	)
+}
+
+// Tuple8Eq compares tuples element-wise.
+func Tuple8Eq[A, B, C, D, E, F, G, H any](e1 Eq[A], e2 Eq[B], e3 Eq[C], e4 Eq[D], e5 Eq[E], e6 Eq[F], e7 Eq[G], e8 Eq[H]) Eq[gs.Tuple8[A, B, C, D, E, F, G, H]] {
+	return EqFrom(func(a, b gs.Tuple8[A, B, C, D, E, F, G, H]) bool {
+		return e1.Equal(a.V1(), b.V1()) &&
+			e2.Equal(a.V2(), b.V2()) &&
+			e3.Equal(a.V3(), b.V3()) &&
+			e4.Equal(a.V4(), b.V4()) &&
+			e5.Equal(a.V5(), b.V5()) &&
+			e6.Equal(a.V6(), b.V6()) &&
+			e7.Equal(a.V7(), b.V7()) &&
+			e8.Equal(a.V8(), b.V8())
+	})
+}
+
+// Tuple8Ord orders tuples lexicographically.
+func Tuple8Ord[A, B, C, D, E, F, G, H any](o1 Ord[A], o2 Ord[B], o3 Ord[C], o4 Ord[D], o5 Ord[E], o6 Ord[F], o7 Ord[G], o8 Ord[H]) Ord[gs.Tuple8[A, B, C, D, E, F, G, H]] {
+	return Then(
+		OrdBy(gs.Tuple8[A, B, C, D, E, F, G, H].V1, o1),
+		OrdBy(gs.Tuple8[A, B, C, D, E, F, G, H].V2, o2),
+		OrdBy(gs.Tuple8[A, B, C, D, E, F, G, H].V3, o3),
+		OrdBy(gs.Tuple8[A, B, C, D, E, F, G, H].V4, o4),
+		OrdBy(gs.Tuple8[A, B, C, D, E, F, G, H].V5, o5),
+		OrdBy(gs.Tuple8[A, B, C, D, E, F, G, H].V6, o6),
+		OrdBy(gs.Tuple8[A, B, C, D, E, F, G, H].V7, o7),
+		OrdBy(gs.Tuple8[A, B, C, D, E, F, G, H].V8, o8),
+	)
+}
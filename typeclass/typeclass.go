@@ -0,0 +1,155 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Package typeclass provides Eq, Ord, Semigroup and Monoid instances. Their
+// methods can be passed wherever the library takes func(T, T) bool,
+// func(T, T) int or func(T, T) T, e.g. Slice.Contains(ord.Equal),
+// Slice.Sort(ord.Compare) or slices.Fold(s, m.Empty(), m.Combine).
+package typeclass
+
+import (
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/slices"
+)
+
+type Eq[T any] interface {
+	Equal(T, T) bool
+}
+
+type Ord[T any] interface {
+	Eq[T]
+	Compare(T, T) int
+}
+
+type Semigroup[T any] interface {
+	Combine(T, T) T
+}
+
+type Monoid[T any] interface {
+	Semigroup[T]
+	Empty() T
+}
+
+type eqFunc[T any] func(T, T) bool
+
+func (f eqFunc[T]) Equal(a, b T) bool {
+	return f(a, b)
+}
+
+// EqFrom makes an Eq from an equality function.
+func EqFrom[T any](eq func(T, T) bool) Eq[T] {
+	return eqFunc[T](eq)
+}
+
+type ordFunc[T any] func(T, T) int
+
+func (f ordFunc[T]) Equal(a, b T) bool {
+	return f(a, b) == 0
+}
+
+func (f ordFunc[T]) Compare(a, b T) int {
+	return f(a, b)
+}
+
+// OrdFrom makes an Ord from a comparison function.
+func OrdFrom[T any](cmp func(T, T) int) Ord[T] {
+	return ordFunc[T](cmp)
+}
+
+type semigroupFunc[T any] func(T, T) T
+
+func (f semigroupFunc[T]) Combine(a, b T) T {
+	return f(a, b)
+}
+
+// SemigroupFrom makes a Semigroup from an associative function.
+func SemigroupFrom[T any](combine func(T, T) T) Semigroup[T] {
+	return semigroupFunc[T](combine)
+}
+
+type monoid[T any] struct {
+	combine func(T, T) T
+	empty   func() T
+}
+
+func (m *monoid[T]) Combine(a, b T) T {
+	return m.combine(a, b)
+}
+
+func (m *monoid[T]) Empty() T {
+	return m.empty()
+}
+
+// MonoidFrom makes a Monoid from an associative function and its identity element.
+func MonoidFrom[T any](combine func(T, T) T, empty func() T) Monoid[T] {
+	return &monoid[T]{
+		combine: combine,
+		empty:   empty,
+	}
+}
+
+// OrdBy orders values by the key fn extracts.
+func OrdBy[T, K any](fn func(T) K, o Ord[K]) Ord[T] {
+	return OrdFrom(func(a, b T) int {
+		return o.Compare(fn(a), fn(b))
+	})
+}
+
+// EqBy compares values by the key fn extracts.
+func EqBy[T, K any](fn func(T) K, e Eq[K]) Eq[T] {
+	return EqFrom(func(a, b T) bool {
+		return e.Equal(fn(a), fn(b))
+	})
+}
+
+// Reverse reverses the order of o.
+func Reverse[T any](o Ord[T]) Ord[T] {
+	return OrdFrom(func(a, b T) int {
+		return o.Compare(b, a)
+	})
+}
+
+// Then orders values by o and breaks ties with each of others in turn.
+func Then[T any](o Ord[T], others ...Ord[T]) Ord[T] {
+	return OrdFrom(func(a, b T) int {
+		if ret := o.Compare(a, b); ret != 0 {
+			return ret
+		}
+		for i := range others {
+			if ret := others[i].Compare(a, b); ret != 0 {
+				return ret
+			}
+		}
+		return 0
+	})
+}
+
+func Max[T any](o Ord[T]) Semigroup[T] {
+	return SemigroupFrom(func(a, b T) T {
+		return gs.Cond(o.Compare(a, b) < 0, b, a)
+	})
+}
+
+func Min[T any](o Ord[T]) Semigroup[T] {
+	return SemigroupFrom(func(a, b T) T {
+		return gs.Cond(o.Compare(b, a) < 0, b, a)
+	})
+}
+
+// Combine returns the Combine function of s, ready for slices.Fold.
+func Combine[T any](s Semigroup[T]) func(T, T) T {
+	return s.Combine
+}
+
+// CombineAll combines all elements of s, starting from the identity of m.
+func CombineAll[T any](m Monoid[T], s gs.Slice[T]) T {
+	return slices.Fold(s, m.Empty(), m.Combine)
+}
+
+// CombineAllOption combines all elements of s, or returns None if s is empty.
+func CombineAllOption[T any](sg Semigroup[T], s gs.Slice[T]) gs.Option[T] {
+	v, ok := s.Reduce(sg.Combine)
+	return gs.Cond(ok, gs.Some(v), gs.None[T]())
+}
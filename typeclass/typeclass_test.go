@@ -0,0 +1,137 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package typeclass_test
+
+import (
+	"strings"
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/maps"
+	"github.com/kigichang/goscala/slices"
+	tc "github.com/kigichang/goscala/typeclass"
+	"github.com/stretchr/testify/assert"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func TestOrd(t *testing.T) {
+	o := tc.Ordered[int]()
+	assert.Equal(t, -1, o.Compare(1, 2))
+	assert.Equal(t, 0, o.Compare(2, 2))
+	assert.True(t, o.Equal(2, 2))
+	assert.Equal(t, 1, tc.Reverse(o).Compare(1, 2))
+
+	s := gs.Slice[int]{3, 1, 2}
+	assert.Equal(t, gs.Slice[int]{1, 2, 3}, s.Sort(o.Compare))
+	assert.True(t, s.Contains(tc.Equal[int]().Equal)(2))
+
+	v, ok := s.Max(o.Compare)
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+}
+
+func TestOrdBy(t *testing.T) {
+	people := gs.Slice[person]{
+		{"b", 20},
+		{"a", 30},
+		{"a", 20},
+	}
+
+	byName := tc.OrdBy(func(p person) string { return p.name }, tc.Ordered[string]())
+	byAge := tc.OrdBy(func(p person) int { return p.age }, tc.Ordered[int]())
+
+	people.Sort(tc.Then(byName, tc.Reverse(byAge)).Compare)
+	assert.Equal(t, gs.Slice[person]{{"a", 30}, {"a", 20}, {"b", 20}}, people)
+
+	people.Sort(tc.Then(byAge, byName).Compare)
+	assert.Equal(t, gs.Slice[person]{{"a", 20}, {"b", 20}, {"a", 30}}, people)
+
+	assert.True(t, tc.EqBy(func(p person) int { return p.age }, tc.Equal[int]()).Equal(people[0], people[1]))
+}
+
+func TestMonoid(t *testing.T) {
+	s := gs.Slice[int]{1, 2, 3, 4}
+
+	assert.Equal(t, 10, tc.CombineAll(tc.Sum[int](), s))
+	assert.Equal(t, 24, tc.CombineAll(tc.Product[int](), s))
+	assert.Equal(t, 10, slices.Fold(s, 0, tc.Combine[int](tc.Sum[int]())))
+	assert.Equal(t, 0, tc.CombineAll(tc.Sum[int](), gs.Slice[int]{}))
+	assert.Equal(t, "abc", tc.CombineAll(tc.Concat[string](), gs.Slice[string]{"a", "b", "c"}))
+
+	assert.Equal(t, 4, tc.CombineAllOption(tc.Max(tc.Ordered[int]()), s).Get())
+	assert.Equal(t, 1, tc.CombineAllOption(tc.Min(tc.Ordered[int]()), s).Get())
+	assert.True(t, tc.CombineAllOption(tc.Max(tc.Ordered[int]()), gs.Slice[int]{}).IsEmpty())
+}
+
+func TestSliceInstances(t *testing.T) {
+	o := tc.SliceOrd(tc.Ordered[int]())
+	assert.Equal(t, -1, o.Compare(gs.Slice[int]{1, 2}, gs.Slice[int]{1, 3}))
+	assert.Equal(t, -1, o.Compare(gs.Slice[int]{1, 2}, gs.Slice[int]{1, 2, 0}))
+	assert.Equal(t, 1, o.Compare(gs.Slice[int]{2}, gs.Slice[int]{1, 2, 0}))
+	assert.True(t, o.Equal(gs.Slice[int]{1, 2}, gs.Slice[int]{1, 2}))
+	assert.True(t, tc.SliceEq(tc.Equal[int]()).Equal(gs.Slice[int]{1, 2}, gs.Slice[int]{1, 2}))
+
+	m := tc.SliceMonoid[int]()
+	assert.Equal(t, gs.Slice[int]{1, 2, 3}, tc.CombineAll(m, gs.Slice[gs.Slice[int]]{{1}, {}, {2, 3}}))
+}
+
+func TestOptionInstances(t *testing.T) {
+	o := tc.OptionOrd(tc.Ordered[int]())
+	assert.Equal(t, -1, o.Compare(gs.None[int](), gs.Some(1)))
+	assert.Equal(t, 1, o.Compare(gs.Some(2), gs.Some(1)))
+	assert.Equal(t, 0, o.Compare(gs.None[int](), gs.None[int]()))
+
+	e := tc.OptionEq(tc.Equal[int]())
+	assert.True(t, e.Equal(gs.Some(1), gs.Some(1)))
+	assert.False(t, e.Equal(gs.Some(1), gs.None[int]()))
+
+	m := tc.OptionMonoid[int](tc.Sum[int]())
+	assert.Equal(t, 3, tc.CombineAll(m, gs.Slice[gs.Option[int]]{gs.Some(1), gs.None[int](), gs.Some(2)}).Get())
+	assert.True(t, tc.CombineAll(m, gs.Slice[gs.Option[int]]{}).IsEmpty())
+}
+
+func TestMapInstances(t *testing.T) {
+	a := maps.From(gs.P("a", 1), gs.P("b", 2))
+	b := maps.From(gs.P("b", 3), gs.P("c", 4))
+
+	m := tc.MapMonoid[string, int](tc.Sum[int]())
+	c := m.Combine(a, b)
+
+	e := tc.MapEq[string](tc.Equal[int]())
+	assert.True(t, e.Equal(c, maps.From(gs.P("a", 1), gs.P("b", 5), gs.P("c", 4))))
+	assert.False(t, e.Equal(c, a))
+	assert.Equal(t, 2, a.Len())
+	assert.True(t, e.Equal(a, m.Combine(a, m.Empty())))
+}
+
+func TestPairInstances(t *testing.T) {
+	o := tc.PairOrd(tc.Ordered[string](), tc.Ordered[int]())
+	assert.Equal(t, -1, o.Compare(gs.P("a", 2), gs.P("b", 1)))
+	assert.Equal(t, 1, o.Compare(gs.P("a", 2), gs.P("a", 1)))
+
+	assert.True(t, tc.PairEq(tc.Equal[string](), tc.Equal[int]()).Equal(gs.P("a", 1), gs.P("a", 1)))
+
+	s := tc.PairSemigroup[string, int](tc.Concat[string](), tc.Sum[int]())
+	p := s.Combine(gs.P("a", 1), gs.P("b", 2))
+	assert.Equal(t, "ab", p.Key())
+	assert.Equal(t, 3, p.Value())
+}
+
+func TestTupleInstances(t *testing.T) {
+	o := tc.Tuple3Ord(tc.Ordered[string](), tc.Ordered[int](), tc.Reverse(tc.Ordered[int]()))
+	assert.Equal(t, -1, o.Compare(gs.T3("a", 2, 0), gs.T3("b", 1, 0)))
+	assert.Equal(t, 1, o.Compare(gs.T3("a", 2, 0), gs.T3("a", 1, 0)))
+	assert.Equal(t, -1, o.Compare(gs.T3("a", 1, 1), gs.T3("a", 1, 0)))
+	assert.Equal(t, 0, o.Compare(gs.T3("a", 1, 1), gs.T3("a", 1, 1)))
+
+	e := tc.Tuple2Eq(tc.EqBy(strings.ToLower, tc.Equal[string]()), tc.Equal[int]())
+	assert.True(t, e.Equal(gs.T2("A", 1), gs.T2("a", 1)))
+	assert.False(t, e.Equal(gs.T2("A", 1), gs.T2("a", 2)))
+}
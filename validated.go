@@ -0,0 +1,165 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package goscala
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+// Validated is either Valid with a value or Invalid with a non-empty list of errors.
+// Unlike Either and Try, combining Validated values accumulates every error.
+type Validated[E, A any] interface {
+	fmt.Stringer
+	fmt.Formatter
+	fmt.GoStringer
+	slog.LogValuer
+	Fetcher[A]
+	IsValid() bool
+	IsInvalid() bool
+	Get() A
+	Errors() Slice[E]
+
+	Exists(func(A) bool) bool
+	Forall(func(A) bool) bool
+	Foreach(func(A))
+	GetOrElse(A) A
+	OrElse(Validated[E, A]) Validated[E, A]
+	Either() Either[Slice[E], A]
+	Option() Option[A]
+	Err() error
+}
+
+type validated[E, A any] struct {
+	v    A
+	errs Slice[E]
+}
+
+var _ Validated[string, int] = &validated[string, int]{}
+
+func (v *validated[E, A]) String() string {
+	return fmt.Sprintf(`%v`, v)
+}
+
+func (v *validated[E, A]) GoString() string {
+	return fmt.Sprintf(`%#v`, v)
+}
+
+// Format prints Valid(v) or Invalid(errs...) for %v, adds the types for %+v and
+// prints Go syntax for %#v.
+func (v *validated[E, A]) Format(f fmt.State, verb rune) {
+	types := TypeName[E]() + `, ` + TypeName[A]()
+	if v.IsValid() {
+		FormatApply(f, verb, `gs`, `Valid`, types, v.v)
+		return
+	}
+	FormatApply(f, verb, `gs`, `Invalid`, types, v.errs.any()...)
+}
+
+// LogValue logs status=valid with the value, or status=invalid with the errors.
+func (v *validated[E, A]) LogValue() slog.Value {
+	if v.IsValid() {
+		return slog.GroupValue(slog.String(`status`, `valid`), slog.Any(`value`, v.v))
+	}
+	return slog.GroupValue(slog.String(`status`, `invalid`), slog.Any(`errors`, []E(v.errs)))
+}
+
+func (v *validated[E, A]) Fetch() (A, bool) {
+	return v.v, v.IsValid()
+}
+
+func (v *validated[E, A]) FetchErr() (A, error) {
+	return v.v, v.Err()
+}
+
+func (v *validated[E, A]) IsValid() bool {
+	return len(v.errs) == 0
+}
+
+func (v *validated[E, A]) IsInvalid() bool {
+	return len(v.errs) > 0
+}
+
+func (v *validated[E, A]) Get() A {
+	if v.IsValid() {
+		return v.v
+	}
+	panic(NoSuchElement[A](`Validated`))
+}
+
+// Errors returns the accumulated errors, or an empty slice if v is Valid.
+func (v *validated[E, A]) Errors() Slice[E] {
+	return Cond(v.IsValid(), SliceEmpty[E](), v.errs.Clone())
+}
+
+func (v *validated[E, A]) Exists(p func(A) bool) bool {
+	return Partial(p, False)(v.Fetch)
+}
+
+func (v *validated[E, A]) Forall(p func(A) bool) bool {
+	return Partial(p, True)(v.Fetch)
+}
+
+func (v *validated[E, A]) Foreach(fn func(A)) {
+	Partial(UnitWrap(fn), Unit)(v.Fetch)
+}
+
+func (v *validated[E, A]) GetOrElse(z A) A {
+	return Default(z)(v.Fetch)
+}
+
+func (v *validated[E, A]) OrElse(z Validated[E, A]) Validated[E, A] {
+	return Cond(v.IsValid(), Validated[E, A](v), z)
+}
+
+func (v *validated[E, A]) Either() Either[Slice[E], A] {
+	if v.IsValid() {
+		return Right[Slice[E], A](v.v)
+	}
+	return Left[Slice[E], A](v.Errors())
+}
+
+func (v *validated[E, A]) Option() Option[A] {
+	return Partial(Some[A], None[A])(v.Fetch)
+}
+
+// Err returns nil if v is Valid, or errors.Join of all errors. Errors that are
+// not error are formatted into new errors.
+func (v *validated[E, A]) Err() error {
+	if v.IsValid() {
+		return nil
+	}
+
+	errs := make([]error, len(v.errs))
+	for i := range v.errs {
+		var x interface{} = v.errs[i]
+		switch e := x.(type) {
+		case error:
+			errs[i] = e
+		default:
+			errs[i] = fmt.Errorf(`%v`, e)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func Valid[E, A any](v A) Validated[E, A] {
+	return &validated[E, A]{
+		v:    v,
+		errs: nil,
+	}
+}
+
+func Invalid[E, A any](err E, errs ...E) Validated[E, A] {
+	all := make([]E, 0, len(errs)+1)
+	all = append(all, err)
+	all = append(all, errs...)
+
+	return &validated[E, A]{
+		errs: all,
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gsgen -t mapn; DO NOT EDIT.
+
+package validated
+
+import (
+	gs "github.com/kigichang/goscala"
+)
+
+// Map2 applies fn to the values of v1 and v2, or returns Invalid with the errors of all of them.
+func Map2[L, A, B, R any](v1 gs.Validated[L, A], v2 gs.Validated[L, B], fn func(A, B) R) gs.Validated[L, R] {
+	errs := gs.Slice[L]{}
+	errs = append(errs, v1.Errors()...)
+	errs = append(errs, v2.Errors()...)
+	if len(errs) > 0 {
+		return gs.Invalid[L, R](errs[0], errs[1:]...)
+	}
+	return gs.Valid[L](fn(v1.Get(), v2.Get()))
+}
+
+// Map3 applies fn to the values of v1, v2 and v3, or returns Invalid with the errors of all of them.
+func Map3[L, A, B, C, R any](v1 gs.Validated[L, A], v2 gs.Validated[L, B], v3 gs.Validated[L, C], fn func(A, B, C) R) gs.Validated[L, R] {
+	errs := gs.Slice[L]{}
+	errs = append(errs, v1.Errors()...)
+	errs = append(errs, v2.Errors()...)
+	errs = append(errs, v3.Errors()...)
+	if len(errs) > 0 {
+		return gs.Invalid[L, R](errs[0], errs[1:]...)
+	}
+	return gs.Valid[L](fn(v1.Get(), v2.Get(), v3.Get()))
+}
+
+// Map4 applies fn to the values of v1, v2, v3 and v4, or returns Invalid with the errors of all of them.
+func Map4[L, A, B, C, D, R any](v1 gs.Validated[L, A], v2 gs.Validated[L, B], v3 gs.Validated[L, C], v4 gs.Validated[L, D], fn func(A, B, C, D) R) gs.Validated[L, R] {
+	errs := gs.Slice[L]{}
+	errs = append(errs, v1.Errors()...)
+	errs = append(errs, v2.Errors()...)
+	errs = append(errs, v3.Errors()...)
+	errs = append(errs, v4.Errors()...)
+	if len(errs) > 0 {
+		return gs.Invalid[L, R](errs[0], errs[1:]...)
+	}
+	return gs.Valid[L](fn(v1.Get(), v2.Get(), v3.Get(), v4.Get()))
+}
+
+// Map5 applies fn to the values of v1, v2, v3, v4 and v5, or returns Invalid with the errors of all of them.
+func Map5[L, A, B, C, D, E, R any](v1 gs.Validated[L, A], v2 gs.Validated[L, B], v3 gs.Validated[L, C], v4 gs.Validated[L, D], v5 gs.Validated[L, E], fn func(A, B, C, D, E) R) gs.Validated[L, R] {
+	errs := gs.Slice[L]{}
+	errs = append(errs, v1.Errors()...)
+	errs = append(errs, v2.Errors()...)
+	errs = append(errs, v3.Errors()...)
+	errs = append(errs, v4.Errors()...)
+	errs = append(errs, v5.Errors()...)
+	if len(errs) > 0 {
+		return gs.Invalid[L, R](errs[0], errs[1:]...)
+	}
+	return gs.Valid[L](fn(v1.Get(), v2.Get(), v3.Get(), v4.Get(), v5.Get()))
+}
+
+// Map6 applies fn to the values of v1, v2, v3, v4, v5 and v6, or returns Invalid with the errors of all of them.
+func Map6[L, A, B, C, D, E, F, R any](v1 gs.Validated[L, A], v2 gs.Validated[L, B], v3 gs.Validated[L, C], v4 gs.Validated[L, D], v5 gs.Validated[L, E], v6 gs.Validated[L, F], fn func(A, B, C, D, E, F) R) gs.Validated[L, R] {
+	errs := gs.Slice[L]{}
+	errs = append(errs, v1.Errors()...)
+	errs = append(errs, v2.Errors()...)
+	errs = append(errs, v3.Errors()...)
+	errs = append(errs, v4.Errors()...)
+	errs = append(errs, v5.Errors()...)
+	errs = append(errs, v6.Errors()...)
+	if len(errs) > 0 {
+		return gs.Invalid[L, R](errs[0], errs[1:]...)
+	}
+	return gs.Valid[L](fn(v1.Get(), v2.Get(), v3.Get(), v4.Get(), v5.Get(), v6.Get()))
+}
+
+// Map7 applies fn to the values of v1, v2, v3, v4, v5, v6 and v7, or returns Invalid with the errors of all of them.
+func Map7[L, A, B, C, D, E, F, G, R any](v1 gs.Validated[L, A], v2 gs.Validated[L, B], v3 gs.Validated[L, C], v4 gs.Validated[L, D], v5 gs.Validated[L, E], v6 gs.Validated[L, F], v7 gs.Validated[L, G], fn func(A, B, C, D, E, F, G) R) gs.Validated[L, R] {
+	errs := gs.Slice[L]{}
+	errs = append(errs, v1.Errors()...)
+	errs = append(errs, v2.Errors()...)
+	errs = append(errs, v3.Errors()...)
+	errs = append(errs, v4.Errors()...)
+	errs = append(errs, v5.Errors()...)
+	errs = append(errs, v6.Errors()...)
+	errs = append(errs, v7.Errors()...)
+	if len(errs) > 0 {
+		return gs.Invalid[L, R](errs[0], errs[1:]...)
+	}
+	return gs.Valid[L](fn(v1.Get(), v2.Get(), v3.Get(), v4.Get(), v5.Get(), v6.Get(), v7.Get()))
+}
+
+// Map8 applies fn to the values of v1, v2, v3, v4, v5, v6, v7 and v8, or returns Invalid with the errors of all of them.
+func Map8[L, A, B, C, D, E, F, G, H, R any](v1 gs.Validated[L, A], v2 gs.Validated[L, B], v3 gs.Validated[L, C], v4 gs.Validated[L, D], v5 gs.Validated[L, E], v6 gs.Validated[L, F], v7 gs.Validated[L, G], v8 gs.Validated[L, H], fn func(A, B, C, D, E, F, G, H) R) gs.Validated[L, R] {
+	errs := gs.Slice[L]{}
+	errs = append(errs, v1.Errors()...)
+	errs = append(errs, v2.Errors()...)
+	errs = append(errs, v3.Errors()...)
+	errs = append(errs, v4.Errors()...)
+	errs = append(errs, v5.Errors()...)
+	errs = append(errs, v6.Errors()...)
+	errs = append(errs, v7.Errors()...)
+	errs = append(errs, v8.Errors()...)
+	if len(errs) > 0 {
+		return gs.Invalid[L, R](errs[0], errs[1:]...)
+	}
+	return gs.Valid[L](fn(v1.Get(), v2.Get(), v3.Get(), v4.Get(), v5.Get(), v6.Get(), v7.Get(), v8.Get()))
+}
@@ -0,0 +1,76 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package validated
+
+//go:generate go run ../cmd/gsgen -t mapn -monad validated -max 8 -o map_gen.go
+//go:generate go run ../cmd/gsgen -t zip -monad validated -max 8 -o zip_gen.go
+
+import (
+	gs "github.com/kigichang/goscala"
+)
+
+// Err returns Valid of v if err is nil, or Invalid of err.
+func Err[A any](v A, err error) gs.Validated[error, A] {
+	if err == nil {
+		return gs.Valid[error](v)
+	}
+	return gs.Invalid[error, A](err)
+}
+
+// Cond returns Valid of v if cond is satisfied, or Invalid of err.
+func Cond[E, A any](cond func() bool, err E, v A) gs.Validated[E, A] {
+	if cond() {
+		return gs.Valid[E](v)
+	}
+	return gs.Invalid[E, A](err)
+}
+
+// Either returns Valid of the right value, or Invalid of the left value.
+func Either[E, A any](e gs.Either[E, A]) gs.Validated[E, A] {
+	if e.IsRight() {
+		return gs.Valid[E](e.Right())
+	}
+	return gs.Invalid[E, A](e.Left())
+}
+
+func Fold[E, A, B any](v gs.Validated[E, A], invalid func(gs.Slice[E]) B, valid func(A) B) B {
+	return gs.Partial(
+		valid,
+		gs.FuncUnitAndThen(v.Errors, invalid),
+	)(v.Fetch)
+}
+
+func Map[E, A, B any](v gs.Validated[E, A], fn func(A) B) gs.Validated[E, B] {
+	return gs.Partial(
+		gs.FuncAndThen(fn, gs.Valid[E, B]),
+		gs.FuncUnitAndThen(v.Errors, invalid[E, B]),
+	)(v.Fetch)
+}
+
+// MapErrors applies fn to every error of v.
+func MapErrors[E, A, E1 any](v gs.Validated[E, A], fn func(E) E1) gs.Validated[E1, A] {
+	if v.IsValid() {
+		return gs.Valid[E1](v.Get())
+	}
+	errs := v.Errors()
+	ret := make([]E1, len(errs))
+	for i := range errs {
+		ret[i] = fn(errs[i])
+	}
+	return invalid[E1, A](ret)
+}
+
+// AndThen binds fn to the valid value. It is for sequential checks and stops at the first Invalid.
+func AndThen[E, A, B any](v gs.Validated[E, A], fn func(A) gs.Validated[E, B]) gs.Validated[E, B] {
+	return gs.Partial(
+		fn,
+		gs.FuncUnitAndThen(v.Errors, invalid[E, B]),
+	)(v.Fetch)
+}
+
+func invalid[E, A any](errs gs.Slice[E]) gs.Validated[E, A] {
+	return gs.Invalid[E, A](errs[0], errs[1:]...)
+}
@@ -0,0 +1,119 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package validated_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/validated"
+	"github.com/stretchr/testify/assert"
+)
+
+type user struct {
+	name  string
+	age   int
+	email string
+}
+
+func checkName(name string) gs.Validated[string, string] {
+	return validated.Cond(func() bool { return name != "" }, "empty name", name)
+}
+
+func checkAge(age int) gs.Validated[string, int] {
+	return validated.Cond(func() bool { return age >= 0 }, "negative age", age)
+}
+
+func checkEmail(email string) gs.Validated[string, string] {
+	return validated.Cond(func() bool { return email != "" }, "empty email", email)
+}
+
+func mkUser(name string, age int, email string) user {
+	return user{name: name, age: age, email: email}
+}
+
+func TestMap3(t *testing.T) {
+	v := validated.Map3(checkName("kigi"), checkAge(10), checkEmail("a@b.c"), mkUser)
+	assert.True(t, v.IsValid())
+	assert.Equal(t, user{"kigi", 10, "a@b.c"}, v.Get())
+
+	v = validated.Map3(checkName(""), checkAge(10), checkEmail(""), mkUser)
+	assert.True(t, v.IsInvalid())
+	assert.Equal(t, gs.Slice[string]{"empty name", "empty email"}, v.Errors())
+
+	v = validated.Map3(checkName(""), checkAge(-1), checkEmail(""), mkUser)
+	assert.Equal(t, gs.Slice[string]{"empty name", "negative age", "empty email"}, v.Errors())
+}
+
+func TestMapN(t *testing.T) {
+	a := gs.Valid[string](1)
+	b := gs.Invalid[string, int]("b")
+	c := gs.Invalid[string, int]("c1", "c2")
+
+	add2 := func(x, y int) int { return x + y }
+	add4 := func(x, y, z, w int) int { return x + y + z + w }
+	add5 := func(x, y, z, w, u int) int { return x + y + z + w + u }
+
+	assert.Equal(t, 2, validated.Map2(a, a, add2).Get())
+	assert.Equal(t, gs.Slice[string]{"b", "c1", "c2"}, validated.Map2(b, c, add2).Errors())
+	assert.Equal(t, 4, validated.Map4(a, a, a, a, add4).Get())
+	assert.Equal(t, gs.Slice[string]{"b", "b"}, validated.Map4(a, b, a, b, add4).Errors())
+	assert.Equal(t, 5, validated.Map5(a, a, a, a, a, add5).Get())
+	assert.Equal(t, gs.Slice[string]{"c1", "c2", "b"}, validated.Map5(a, c, a, a, b, add5).Errors())
+}
+
+func TestZip(t *testing.T) {
+	v := validated.Zip(gs.Valid[string](1), gs.Valid[string]("a"))
+	assert.Equal(t, 1, v.Get().V1())
+	assert.Equal(t, "a", v.Get().V2())
+
+	v = validated.Zip(gs.Invalid[string, int]("x"), gs.Invalid[string, string]("y"))
+	assert.Equal(t, gs.Slice[string]{"x", "y"}, v.Errors())
+
+	assert.Equal(t, gs.T3(1, "a", true), validated.Zip3(gs.Valid[string](1), gs.Valid[string]("a"), gs.Valid[string](true)).Get())
+	w := validated.Zip3(gs.Invalid[string, int]("x"), gs.Valid[string]("a"), gs.Invalid[string, bool]("z"))
+	assert.Equal(t, gs.Slice[string]{"x", "z"}, w.Errors())
+}
+
+func TestMapAndAndThen(t *testing.T) {
+	v := validated.Map(gs.Valid[string](1), strconv.Itoa)
+	assert.Equal(t, "1", v.Get())
+
+	v = validated.Map(gs.Invalid[string, int]("x"), strconv.Itoa)
+	assert.Equal(t, gs.Slice[string]{"x"}, v.Errors())
+
+	parse := func(s string) gs.Validated[error, int] {
+		return validated.Err(strconv.Atoi(s))
+	}
+
+	positive := func(x int) gs.Validated[error, int] {
+		return validated.Cond(func() bool { return x > 0 }, fmt.Errorf("%d is not positive", x), x)
+	}
+
+	assert.Equal(t, 1, validated.AndThen(parse("1"), positive).Get())
+	assert.Equal(t, "-1 is not positive", validated.AndThen(parse("-1"), positive).Err().Error())
+	assert.Equal(t, 1, validated.AndThen(parse("x"), positive).Errors().Len())
+}
+
+func TestMapErrorsAndFold(t *testing.T) {
+	v := validated.MapErrors(gs.Invalid[int, string](1, 2), strconv.Itoa)
+	assert.Equal(t, gs.Slice[string]{"1", "2"}, v.Errors())
+
+	w := validated.MapErrors(gs.Valid[int]("a"), strconv.Itoa)
+	assert.Equal(t, "a", w.Get())
+
+	count := func(errs gs.Slice[string]) int { return errs.Len() }
+	length := func(s string) int { return len(s) }
+	assert.Equal(t, 2, validated.Fold(v, count, length))
+	assert.Equal(t, 1, validated.Fold(w, count, length))
+}
+
+func TestEither(t *testing.T) {
+	assert.Equal(t, 1, validated.Either(gs.Right[string, int](1)).Get())
+	assert.Equal(t, gs.Slice[string]{"x"}, validated.Either(gs.Left[string, int]("x")).Errors())
+}
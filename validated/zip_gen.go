@@ -0,0 +1,110 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gsgen -t zip; DO NOT EDIT.
+
+package validated
+
+import (
+	gs "github.com/kigichang/goscala"
+)
+
+// Zip tuples the values of v1 and v2, or returns Invalid with the errors of all of them.
+func Zip[L, A, B any](v1 gs.Validated[L, A], v2 gs.Validated[L, B]) gs.Validated[L, gs.Tuple2[A, B]] {
+	errs := gs.Slice[L]{}
+	errs = append(errs, v1.Errors()...)
+	errs = append(errs, v2.Errors()...)
+	if len(errs) > 0 {
+		return gs.Invalid[L, gs.Tuple2[A, B]](errs[0], errs[1:]...)
+	}
+	return gs.Valid[L](gs.T2[A, B](v1.Get(), v2.Get()))
+}
+
+// Zip3 tuples the values of v1, v2 and v3, or returns Invalid with the errors of all of them.
+func Zip3[L, A, B, C any](v1 gs.Validated[L, A], v2 gs.Validated[L, B], v3 gs.Validated[L, C]) gs.Validated[L, gs.Tuple3[A, B, C]] {
+	errs := gs.Slice[L]{}
+	errs = append(errs, v1.Errors()...)
+	errs = append(errs, v2.Errors()...)
+	errs = append(errs, v3.Errors()...)
+	if len(errs) > 0 {
+		return gs.Invalid[L, gs.Tuple3[A, B, C]](errs[0], errs[1:]...)
+	}
+	return gs.Valid[L](gs.T3[A, B, C](v1.Get(), v2.Get(), v3.Get()))
+}
+
+// Zip4 tuples the values of v1, v2, v3 and v4, or returns Invalid with the errors of all of them.
+func Zip4[L, A, B, C, D any](v1 gs.Validated[L, A], v2 gs.Validated[L, B], v3 gs.Validated[L, C], v4 gs.Validated[L, D]) gs.Validated[L, gs.Tuple4[A, B, C, D]] {
+	errs := gs.Slice[L]{}
+	errs = append(errs, v1.Errors()...)
+	errs = append(errs, v2.Errors()...)
+	errs = append(errs, v3.Errors()...)
+	errs = append(errs, v4.Errors()...)
+	if len(errs) > 0 {
+		return gs.Invalid[L, gs.Tuple4[A, B, C, D]](errs[0], errs[1:]...)
+	}
+	return gs.Valid[L](gs.T4[A, B, C, D](v1.Get(), v2.Get(), v3.Get(), v4.Get()))
+}
+
+// Zip5 tuples the values of v1, v2, v3, v4 and v5, or returns Invalid with the errors of all of them.
+func Zip5[L, A, B, C, D, E any](v1 gs.Validated[L, A], v2 gs.Validated[L, B], v3 gs.Validated[L, C], v4 gs.Validated[L, D], v5 gs.Validated[L, E]) gs.Validated[L, gs.Tuple5[A, B, C, D, E]] {
+	errs := gs.Slice[L]{}
+	errs = append(errs, v1.Errors()...)
+	errs = append(errs, v2.Errors()...)
+	errs = append(errs, v3.Errors()...)
+	errs = append(errs, v4.Errors()...)
+	errs = append(errs, v5.Errors()...)
+	if len(errs) > 0 {
+		return gs.Invalid[L, gs.Tuple5[A, B, C, D, E]](errs[0], errs[1:]...)
+	}
+	return gs.Valid[L](gs.T5[A, B, C, D, E](v1.Get(), v2.Get(), v3.Get(), v4.Get(), v5.Get()))
+}
+
+// Zip6 tuples the values of v1, v2, v3, v4, v5 and v6, or returns Invalid with the errors of all of them.
+func Zip6[L, A, B, C, D, E, F any](v1 gs.Validated[L, A], v2 gs.Validated[L, B], v3 gs.Validated[L, C], v4 gs.Validated[L, D], v5 gs.Validated[L, E], v6 gs.Validated[L, F]) gs.Validated[L, gs.Tuple6[A, B, C, D, E, F]] {
+	errs := gs.Slice[L]{}
+	errs = append(errs, v1.Errors()...)
+	errs = append(errs, v2.Errors()...)
+	errs = append(errs, v3.Errors()...)
+	errs = append(errs, v4.Errors()...)
+	errs = append(errs, v5.Errors()...)
+	errs = append(errs, v6.Errors()...)
+	if len(errs) > 0 {
+		return gs.Invalid[L, gs.Tuple6[A, B, C, D, E, F]](errs[0], errs[1:]...)
+	}
+	return gs.Valid[L](gs.T6[A, B, C, D, E, F](v1.Get(), v2.Get(), v3.Get(), v4.Get(), v5.Get(), v6.Get()))
+}
+
+// Zip7 tuples the values of v1, v2, v3, v4, v5, v6 and v7, or returns Invalid with the errors of all of them.
+func Zip7[L, A, B, C, D, E, F, G any](v1 gs.Validated[L, A], v2 gs.Validated[L, B], v3 gs.Validated[L, C], v4 gs.Validated[L, D], v5 gs.Validated[L, E], v6 gs.Validated[L, F], v7 gs.Validated[L, G]) gs.Validated[L, gs.Tuple7[A, B, C, D, E, F, G]] {
+	errs := gs.Slice[L]{}
+	errs = append(errs, v1.Errors()...)
+	errs = append(errs, v2.Errors()...)
+	errs = append(errs, v3.Errors()...)
+	errs = append(errs, v4.Errors()...)
+	errs = append(errs, v5.Errors()...)
+	errs = append(errs, v6.Errors()...)
+	errs = append(errs, v7.Errors()...)
+	if len(errs) > 0 {
+		return gs.Invalid[L, gs.Tuple7[A, B, C, D, E, F, G]](errs[0], errs[1:]...)
+	}
+	return gs.Valid[L](gs.T7[A, B, C, D, E, F, G](v1.Get(), v2.Get(), v3.Get(), v4.Get(), v5.Get(), v6.Get(), v7.Get()))
+}
+
+// Zip8 tuples the values of v1, v2, v3, v4, v5, v6, v7 and v8, or returns Invalid with the errors of all of them.
+func Zip8[L, A, B, C, D, E, F, G, H any](v1 gs.Validated[L, A], v2 gs.Validated[L, B], v3 gs.Validated[L, C], v4 gs.Validated[L, D], v5 gs.Validated[L, E], v6 gs.Validated[L, F], v7 gs.Validated[L, G], v8 gs.Validated[L, H]) gs.Validated[L, gs.Tuple8[A, B, C, D, E, F, G, H]] {
+	errs := gs.Slice[L]{}
+	errs = append(errs, v1.Errors()...)
+	errs = append(errs, v2.Errors()...)
+	errs = append(errs, v3.Errors()...)
+	errs = append(errs, v4.Errors()...)
+	errs = append(errs, v5.Errors()...)
+	errs = append(errs, v6.Errors()...)
+	errs = append(errs, v7.Errors()...)
+	errs = append(errs, v8.Errors()...)
+	if len(errs) > 0 {
+		return gs.Invalid[L, gs.Tuple8[A, B, C, D, E, F, G, H]](errs[0], errs[1:]...)
+	}
+	return gs.Valid[L](gs.T8[A, B, C, D, E, F, G, H](v1.Get(), v2.Get(), v3.Get(), v4.Get(), v5.Get(), v6.Get(), v7.Get(), v8.Get()))
+}
@@ -0,0 +1,58 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package goscala_test
+
+import (
+	"errors"
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValid(t *testing.T) {
+	v := gs.Valid[string](1)
+	t.Log(v)
+	assert.True(t, v.IsValid())
+	assert.False(t, v.IsInvalid())
+	assert.Equal(t, 1, v.Get())
+	assert.Equal(t, 0, v.Errors().Len())
+	assert.Nil(t, v.Err())
+	assert.Equal(t, 1, v.Either().Right())
+	assert.Equal(t, 1, v.Option().Get())
+	assert.True(t, v.Exists(func(x int) bool { return x > 0 }))
+	assert.Equal(t, 1, v.GetOrElse(2))
+
+	x, err := v.FetchErr()
+	assert.Equal(t, 1, x)
+	assert.Nil(t, err)
+}
+
+func TestInvalid(t *testing.T) {
+	v := gs.Invalid[string, int]("a", "b")
+	t.Log(v)
+	assert.Equal(t, "Invalid(a, b)", v.String())
+	assert.False(t, v.IsValid())
+	assert.True(t, v.IsInvalid())
+	assert.Panics(t, func() { v.Get() })
+	assert.Equal(t, gs.Slice[string]{"a", "b"}, v.Errors())
+	assert.Equal(t, gs.Slice[string]{"a", "b"}, v.Either().Left())
+	assert.True(t, v.Option().IsEmpty())
+	assert.True(t, v.Forall(func(x int) bool { return x > 0 }))
+	assert.Equal(t, 2, v.GetOrElse(2))
+	assert.Equal(t, 3, v.OrElse(gs.Valid[string](3)).Get())
+	assert.Equal(t, "a\nb", v.Err().Error())
+}
+
+func TestValidatedErr(t *testing.T) {
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+
+	err := gs.Invalid[error, int](err1, err2).Err()
+	assert.True(t, errors.Is(err, err1))
+	assert.True(t, errors.Is(err, err2))
+	assert.Equal(t, "err1\nerr2", err.Error())
+}
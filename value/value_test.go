@@ -0,0 +1,199 @@
+// Copyright © 2021 Kigi Chang <kigi.chang@gmail.com>
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package value_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	gs "github.com/kigichang/goscala"
+	"github.com/kigichang/goscala/either"
+	"github.com/kigichang/goscala/try"
+	"github.com/kigichang/goscala/value"
+	"github.com/stretchr/testify/assert"
+)
+
+var errTest = errors.New("test")
+
+func positive(v int) bool {
+	return v > 0
+}
+
+func double(v int) int {
+	return v * 2
+}
+
+func TestOption(t *testing.T) {
+	o := value.Some(1)
+	assert.Equal(t, "Some(1)", o.String())
+	assert.True(t, o.IsDefined())
+	assert.Equal(t, 1, o.Get())
+	assert.Equal(t, 1, o.GetOrElse(2))
+	assert.True(t, o.Exists(positive))
+	assert.True(t, o.Filter(positive).IsDefined())
+	assert.True(t, o.FilterNot(positive).IsEmpty())
+	assert.True(t, o.Contains(1, gs.Eq[int]))
+	assert.Equal(t, 2, value.MapOption(o, double).Get())
+	assert.Equal(t, 1, o.Boxed().Get())
+
+	n := value.None[int]()
+	assert.Equal(t, "None", n.String())
+	assert.Equal(t, "None[int]", fmt.Sprintf("%+v", n))
+	assert.True(t, n.IsEmpty())
+	assert.Panics(t, func() { n.Get() })
+	assert.Equal(t, 2, n.GetOrElse(2))
+	assert.True(t, n.Forall(positive))
+	assert.Equal(t, 3, n.OrElse(value.Some(3)).Get())
+	assert.True(t, value.FlatMapOption(n, func(v int) value.Option[int] { return value.Some(v) }).IsEmpty())
+	assert.True(t, n.Boxed().IsEmpty())
+	assert.Equal(t, n, value.Option[int]{})
+
+	_, err := n.FetchErr()
+	assert.ErrorIs(t, err, gs.ErrEmpty)
+	assert.Equal(t, o, value.FromOption(gs.Some(1)))
+}
+
+func TestTry(t *testing.T) {
+	s := value.Success(1)
+	assert.Equal(t, "Success(1)", s.String())
+	assert.True(t, s.IsSuccess())
+	assert.Equal(t, 1, s.Get())
+	assert.Equal(t, gs.ErrUnsupported, s.Failed())
+	assert.ErrorIs(t, s.Filter(func(int) bool { return false }).Failed(), gs.ErrUnsatisfied)
+	assert.Equal(t, 2, value.MapTry(s, double).Get())
+	assert.Equal(t, 1, s.Either().Right())
+	assert.Equal(t, 1, s.Option().Get())
+
+	f := value.Failure[int](errTest)
+	assert.True(t, f.IsFailure())
+	assert.Panics(t, func() { f.Get() })
+	assert.Panics(t, func() { value.Failure[int](nil) })
+	assert.Equal(t, errTest, value.MapTry(f, double).Failed())
+	assert.Equal(t, errTest, f.Either().Left())
+	assert.Equal(t, 3, f.Recover(func(error) (int, bool) { return 3, true }).Get())
+	assert.Equal(t, 2, f.GetOrElse(2))
+	assert.Equal(t, errTest, f.Boxed().Failed())
+
+	atoi := func(s string) value.Try[int] { return value.TryErr(strconv.Atoi(s)) }
+	assert.Equal(t, 12, value.FlatMapTry(value.Success("12"), atoi).Get())
+	assert.True(t, value.FlatMapTry(value.Success("x"), atoi).IsFailure())
+	assert.Equal(t, f, value.FromTry(gs.Failure[int](errTest)))
+}
+
+func TestEither(t *testing.T) {
+	r := value.Right[string](1)
+	assert.Equal(t, "Right(1)", r.String())
+	assert.True(t, r.IsRight())
+	assert.Equal(t, 1, r.Get())
+	assert.Equal(t, "x", r.FilterOrElse(func(int) bool { return false }, "x").Left())
+	assert.Equal(t, 2, value.MapEither(r, double).Right())
+	assert.Equal(t, 1, r.Swap().Left())
+	assert.Equal(t, 1, r.Boxed().Right())
+
+	l := value.Left[string, int]("x")
+	assert.Equal(t, "Left(x)", l.String())
+	assert.True(t, l.IsLeft())
+	assert.Panics(t, func() { l.Get() })
+	assert.Equal(t, 2, l.GetOrElse(2))
+	assert.True(t, l.Option().IsEmpty())
+	assert.Equal(t, "x", value.FlatMapEither(l, func(v int) value.Either[string, int] { return value.Right[string](v) }).Left())
+	assert.Equal(t, l, value.FromEither(gs.Left[string, int]("x")))
+
+	_, err := l.FetchErr()
+	assert.ErrorIs(t, err, gs.ErrLeft)
+}
+
+func TestAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		o := value.Some(1)
+		_ = o.GetOrElse(0)
+		_ = o.Filter(positive).Exists(positive)
+		_ = value.MapOption(o, double).Get()
+
+		tr := value.Success(1)
+		_ = value.MapTry(tr, double).GetOrElse(0)
+		_ = value.Failure[int](errTest).Recover(func(error) (int, bool) { return 0, true })
+
+		e := value.Right[string](1)
+		_ = value.FlatMapEither(e, func(v int) value.Either[string, int] { return value.Right[string](v) }).Exists(positive)
+	})
+	assert.Equal(t, float64(0), allocs)
+}
+
+var (
+	sinkInt  int
+	sinkBool bool
+)
+
+func BenchmarkOptionGetOrElse(b *testing.B) {
+	b.Run("gs", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkInt = gs.Some(i).GetOrElse(0)
+		}
+	})
+	b.Run("value", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkInt = value.Some(i).GetOrElse(0)
+		}
+	})
+}
+
+func BenchmarkOptionFilterExists(b *testing.B) {
+	b.Run("gs", func(b *testing.B) {
+		b.ReportAllocs()
+		o := gs.Some(1)
+		for i := 0; i < b.N; i++ {
+			sinkBool = o.Filter(positive).Exists(positive)
+		}
+	})
+	b.Run("value", func(b *testing.B) {
+		b.ReportAllocs()
+		o := value.Some(1)
+		for i := 0; i < b.N; i++ {
+			sinkBool = o.Filter(positive).Exists(positive)
+		}
+	})
+}
+
+func BenchmarkTryMap(b *testing.B) {
+	b.Run("gs", func(b *testing.B) {
+		b.ReportAllocs()
+		t := gs.Success(1)
+		for i := 0; i < b.N; i++ {
+			sinkInt = try.Map(t, double).GetOrElse(0)
+		}
+	})
+	b.Run("value", func(b *testing.B) {
+		b.ReportAllocs()
+		t := value.Success(1)
+		for i := 0; i < b.N; i++ {
+			sinkInt = value.MapTry(t, double).GetOrElse(0)
+		}
+	})
+}
+
+func BenchmarkEitherFlatMap(b *testing.B) {
+	b.Run("gs", func(b *testing.B) {
+		b.ReportAllocs()
+		e := gs.Right[string](1)
+		fn := func(v int) gs.Either[string, int] { return gs.Right[string](v * 2) }
+		for i := 0; i < b.N; i++ {
+			sinkInt = either.FlatMap(e, fn).GetOrElse(0)
+		}
+	})
+	b.Run("value", func(b *testing.B) {
+		b.ReportAllocs()
+		e := value.Right[string](1)
+		fn := func(v int) value.Either[string, int] { return value.Right[string](v * 2) }
+		for i := 0; i < b.N; i++ {
+			sinkInt = value.FlatMapEither(e, fn).GetOrElse(0)
+		}
+	})
+}